@@ -0,0 +1,40 @@
+package calendar
+
+import (
+	"io"
+	"testing"
+)
+
+// benchmarkDates are Gregorian dates spread over a Jalali year, so that both
+// halves of the year and the days before Nowruz are converted
+var benchmarkDates = [][3]int{
+	{2024, 1, 15}, {2024, 3, 19}, {2024, 3, 20}, {2024, 7, 22},
+	{2024, 9, 22}, {2024, 12, 31}, {2025, 2, 28}, {2025, 3, 20},
+}
+
+// benchmarkSink keeps the compiler from dropping the conversions being measured
+var benchmarkSink int
+
+func BenchmarkGregorianToJalali(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		d := benchmarkDates[i%len(benchmarkDates)]
+		benchmarkSink += GregorianToJalali(d[0], d[1], d[2]).Day
+	}
+}
+
+func BenchmarkJalaliToGregorian(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _, gd := JalaliToGregorian(1403, i%12+1, i%29+1)
+		benchmarkSink += gd
+	}
+}
+
+func BenchmarkDisplayYearTable(b *testing.B) {
+	opts := Options{Today: JalaliDate{Year: 1403, Month: 5, Day: 12}, Holidays: true, Output: io.Discard}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := DisplayYearTable(1403, opts); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
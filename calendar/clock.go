@@ -0,0 +1,36 @@
+package calendar
+
+import "time"
+
+// Clock tells the current time. Passing a Clock instead of calling time.Now
+// lets callers fix "now", e.g. to render the calendar as it looks on a given day.
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock is the Clock reading the system time
+type SystemClock struct{}
+
+// Now returns the current system time
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// FixedClock is a Clock that is stopped at a given time
+type FixedClock time.Time
+
+// Now returns the time the clock is stopped at
+func (c FixedClock) Now() time.Time {
+	return time.Time(c)
+}
+
+// Now returns today's Jalali date in the local time zone
+func Now() JalaliDate {
+	return FromTime(time.Now())
+}
+
+// NowIn returns today's Jalali date in loc, which may differ from Now around
+// midnight, e.g. NowIn(time.UTC) for the date in UTC
+func NowIn(loc *time.Location) JalaliDate {
+	return FromTime(time.Now().In(loc))
+}
@@ -0,0 +1,193 @@
+package calendar
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ColorDepth is the number of colors a terminal can show
+type ColorDepth int
+
+const (
+	// Color16 is the basic palette of 8 colors and their bright variants
+	Color16 ColorDepth = iota
+	// Color256 is the xterm palette of 256 indexed colors
+	Color256
+	// TrueColor is 24-bit RGB color
+	TrueColor
+)
+
+// DetectColorDepth returns the color depth announced by the COLORTERM and TERM
+// environment values. Terminals that announce neither are assumed to show the
+// basic 16 colors, which every color terminal supports.
+func DetectColorDepth(colorterm, term string) ColorDepth {
+	switch {
+	case colorterm == "truecolor" || colorterm == "24bit":
+		return TrueColor
+	case strings.Contains(term, "256color"):
+		return Color256
+	default:
+		return Color16
+	}
+}
+
+// basicColors are the RGB values of the 16 basic colors, as shown by xterm
+var basicColors = [16][3]int{
+	{0, 0, 0}, {205, 0, 0}, {0, 205, 0}, {205, 205, 0},
+	{0, 0, 238}, {205, 0, 205}, {0, 205, 205}, {229, 229, 229},
+	{127, 127, 127}, {255, 0, 0}, {0, 255, 0}, {255, 255, 0},
+	{92, 92, 255}, {255, 0, 255}, {0, 255, 255}, {255, 255, 255},
+}
+
+// cubeLevels are the channel values of the 6x6x6 color cube of the 256 color palette
+var cubeLevels = [6]int{0, 95, 135, 175, 215, 255}
+
+// ParseHexColor returns the SGR foreground code for a "#RRGGBB" color
+func ParseHexColor(hex string) (string, error) {
+	value, found := strings.CutPrefix(hex, "#")
+	if !found || len(value) != 6 {
+		return "", fmt.Errorf("invalid color %q (expected #RRGGBB)", hex)
+	}
+	rgb, err := strconv.ParseUint(value, 16, 32)
+	if err != nil {
+		return "", fmt.Errorf("invalid color %q (expected #RRGGBB)", hex)
+	}
+	return fmt.Sprintf("38;2;%d;%d;%d", rgb>>16, rgb>>8&0xff, rgb&0xff), nil
+}
+
+// ForDepth returns the theme with its 24-bit and 256 color codes replaced by the
+// nearest colors a terminal of the given depth can show. Basic colors and text
+// styles are kept as they are.
+func (t Theme) ForDepth(depth ColorDepth) Theme {
+	for _, field := range themeElements(&t) {
+		*field = codeForDepth(*field, depth)
+	}
+	return t
+}
+
+// codeForDepth rewrites the extended colors of an SGR code for depth
+func codeForDepth(code string, depth ColorDepth) string {
+	if depth == TrueColor || code == "" {
+		return code
+	}
+
+	params := strings.Split(code, ";")
+	out := make([]string, 0, len(params))
+	for i := 0; i < len(params); i++ {
+		p := params[i]
+		if (p != "38" && p != "48") || i+1 >= len(params) {
+			out = append(out, p)
+			continue
+		}
+		background := p == "48"
+
+		switch {
+		case params[i+1] == "2" && i+4 < len(params):
+			r, g, b := atoi(params[i+2]), atoi(params[i+3]), atoi(params[i+4])
+			i += 4
+			if depth == Color256 {
+				out = append(out, p, "5", strconv.Itoa(nearestIndexed(r, g, b)))
+			} else {
+				out = append(out, basicCode(nearestBasic(r, g, b), background))
+			}
+		case params[i+1] == "5" && i+2 < len(params):
+			index := atoi(params[i+2])
+			i += 2
+			if depth == Color256 {
+				out = append(out, p, "5", strconv.Itoa(index))
+			} else {
+				r, g, b := indexedRGB(index)
+				out = append(out, basicCode(nearestBasic(r, g, b), background))
+			}
+		default:
+			out = append(out, p)
+		}
+	}
+	return strings.Join(out, ";")
+}
+
+// atoi converts an SGR parameter already checked to be numeric
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+// nearestIndexed returns the color of the 256 color palette closest to r, g, b,
+// from either the color cube or the gray ramp
+func nearestIndexed(r, g, b int) int {
+	ri, gi, bi := cubeIndex(r), cubeIndex(g), cubeIndex(b)
+	cube := 16 + 36*ri + 6*gi + bi
+
+	// The gray ramp runs from 8 to 238 in steps of 10
+	gray := min(max((r+g+b)/3-8+5, 0)/10, 23)
+	grayLevel := 8 + 10*gray
+
+	if colorDistance(r, g, b, grayLevel, grayLevel, grayLevel) < colorDistance(r, g, b, cubeLevels[ri], cubeLevels[gi], cubeLevels[bi]) {
+		return 232 + gray
+	}
+	return cube
+}
+
+// cubeIndex returns the cube level closest to a channel value
+func cubeIndex(v int) int {
+	best := 0
+	for i, level := range cubeLevels {
+		if abs(v-level) < abs(v-cubeLevels[best]) {
+			best = i
+		}
+	}
+	return best
+}
+
+// indexedRGB returns the RGB value of a color of the 256 color palette
+func indexedRGB(index int) (int, int, int) {
+	switch {
+	case index < 16:
+		c := basicColors[max(index, 0)]
+		return c[0], c[1], c[2]
+	case index < 232:
+		index -= 16
+		return cubeLevels[index/36], cubeLevels[index/6%6], cubeLevels[index%6]
+	default:
+		level := 8 + 10*(min(index, 255)-232)
+		return level, level, level
+	}
+}
+
+// nearestBasic returns the basic color (0-15) closest to r, g, b
+func nearestBasic(r, g, b int) int {
+	best := 0
+	for i, c := range basicColors {
+		if colorDistance(r, g, b, c[0], c[1], c[2]) < colorDistance(r, g, b, basicColors[best][0], basicColors[best][1], basicColors[best][2]) {
+			best = i
+		}
+	}
+	return best
+}
+
+// basicCode returns the SGR code for a basic color as foreground or background
+func basicCode(color int, background bool) string {
+	base := 30
+	if color >= 8 {
+		base, color = 90, color-8
+	}
+	if background {
+		base += 10
+	}
+	return strconv.Itoa(base + color)
+}
+
+// colorDistance returns the squared distance between two RGB colors
+func colorDistance(r1, g1, b1, r2, g2, b2 int) int {
+	dr, dg, db := r1-r2, g1-g2, b1-b2
+	return dr*dr + dg*dg + db*db
+}
+
+// abs returns the absolute value of n
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
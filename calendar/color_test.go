@@ -0,0 +1,92 @@
+package calendar
+
+import "testing"
+
+func TestDetectColorDepth(t *testing.T) {
+	tests := []struct {
+		colorterm, term string
+		want            ColorDepth
+	}{
+		{"truecolor", "xterm-256color", TrueColor},
+		{"24bit", "xterm", TrueColor},
+		{"", "xterm-256color", Color256},
+		{"", "screen-256color", Color256},
+		{"", "xterm", Color16},
+		{"", "", Color16},
+		{"yes", "linux", Color16},
+	}
+	for _, tt := range tests {
+		if got := DetectColorDepth(tt.colorterm, tt.term); got != tt.want {
+			t.Errorf("DetectColorDepth(%q, %q) = %d, want %d", tt.colorterm, tt.term, got, tt.want)
+		}
+	}
+}
+
+func TestParseHexColor(t *testing.T) {
+	tests := []struct {
+		hex     string
+		want    string
+		wantErr bool
+	}{
+		{"#ff0000", "38;2;255;0;0", false},
+		{"#1E90FF", "38;2;30;144;255", false},
+		{"#000000", "38;2;0;0;0", false},
+		{"ff0000", "", true},
+		{"#fff", "", true},
+		{"#gg0000", "", true},
+		{"#ff00001", "", true},
+	}
+	for _, tt := range tests {
+		got, err := ParseHexColor(tt.hex)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseHexColor(%q) error %v, want error %v", tt.hex, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseHexColor(%q) = %q, want %q", tt.hex, got, tt.want)
+		}
+	}
+}
+
+func TestCodeForDepth(t *testing.T) {
+	tests := []struct {
+		code  string
+		depth ColorDepth
+		want  string
+	}{
+		{"38;2;255;0;0", TrueColor, "38;2;255;0;0"},
+		{"38;2;255;0;0", Color256, "38;5;196"},
+		{"38;2;255;0;0", Color16, "91"},
+		// a mid gray is closer to the gray ramp than to the color cube
+		{"1;38;2;128;128;128", Color256, "1;38;5;244"},
+		{"1;38;2;128;128;128", Color16, "1;90"},
+		{"48;5;21", Color256, "48;5;21"},
+		{"48;5;21", Color16, "44"},
+		{"38;5;9", Color16, "91"},
+		{"1;33", Color16, "1;33"},
+		{"", Color16, ""},
+		// an incomplete extended color is passed through
+		{"38;2;10", Color16, "38;2;10"},
+	}
+	for _, tt := range tests {
+		if got := codeForDepth(tt.code, tt.depth); got != tt.want {
+			t.Errorf("codeForDepth(%q, %d) = %q, want %q", tt.code, tt.depth, got, tt.want)
+		}
+	}
+}
+
+func TestThemeForDepth(t *testing.T) {
+	theme := Theme{Header: "1;36", Today: "38;2;255;0;0", Holiday: "48;5;21"}
+
+	got := theme.ForDepth(Color16)
+	want := Theme{Header: "1;36", Today: "91", Holiday: "44"}
+	if got != want {
+		t.Errorf("ForDepth(Color16) = %+v, want %+v", got, want)
+	}
+	if theme.Today != "38;2;255;0;0" {
+		t.Errorf("ForDepth changed the receiver: Today = %q", theme.Today)
+	}
+	if got := theme.ForDepth(TrueColor); got != theme {
+		t.Errorf("ForDepth(TrueColor) = %+v, want the theme unchanged", got)
+	}
+}
@@ -0,0 +1,85 @@
+package calendar
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// TestConcurrentConversions hammers the conversions and holiday lookups from
+// several goroutines while holidays are being loaded. Run it with -race.
+func TestConcurrentConversions(t *testing.T) {
+	const (
+		goroutines = 8
+		year       = 1380 // not used by the other tests that load holidays
+	)
+	t.Cleanup(func() {
+		lunarHolidaysMu.Lock()
+		delete(lunarHolidays, year)
+		lunarHolidaysMu.Unlock()
+	})
+
+	// The results computed by a single goroutine, to compare the others against
+	type monthResult struct {
+		dates []JalaliDate
+		grid  [][]int
+		table string
+	}
+	first, last := JalaliToJDN(FirstDayOfYear(year)), JalaliToJDN(LastDayOfYear(year))
+	want := make(map[int]monthResult)
+	for month := 1; month <= 12; month++ {
+		want[month] = monthResult{grid: GetMonthCalendar(year, month), table: RenderMonth(year, month, plain)}
+	}
+	for jdn := first; jdn <= last; jdn++ {
+		d := JDNToJalali(jdn)
+		r := want[d.Month]
+		r.dates = append(r.dates, d)
+		want[d.Month] = r
+	}
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for month := 1; month <= 12; month++ {
+				for _, d := range want[month].dates {
+					gy, gm, gd := JalaliToGregorian(d.Year, d.Month, d.Day)
+					if got := GregorianToJalali(gy, gm, gd); got != d {
+						t.Errorf("GregorianToJalali(%d, %d, %d) = %v, want %v", gy, gm, gd, got, d)
+						return
+					}
+					HolidaysOn(d)
+				}
+				if got := GetMonthCalendar(year, month); !reflect.DeepEqual(got, want[month].grid) {
+					t.Errorf("GetMonthCalendar(%d, %d) = %v, want %v", year, month, got, want[month].grid)
+					return
+				}
+				if got := RenderMonth(year, month, plain); got != want[month].table {
+					t.Errorf("RenderMonth(%d, %d) differs when run concurrently", year, month)
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for day := 1; day <= 20; day++ {
+			file := fmt.Sprintf(`{"year": %d, "holidays": [{"month": 2, "day": %d, "name": "Test %d"}]}`, year, day, day)
+			if err := LoadHolidays(strings.NewReader(file)); err != nil {
+				t.Errorf("LoadHolidays: %v", err)
+				return
+			}
+			YearHolidays(year)
+		}
+	}()
+	wg.Wait()
+
+	if got := len(MonthHolidays(year, 2)); got != 20 {
+		t.Errorf("%d holidays loaded in Ordibehesht %d, want 20", got, year)
+	}
+}
@@ -0,0 +1,112 @@
+package calendar
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Precision is how much of the time of day a date and time string gives
+type Precision int
+
+const (
+	// PrecisionDay is a date alone
+	PrecisionDay Precision = iota
+	// PrecisionMinute is a date with hours and minutes
+	PrecisionMinute
+	// PrecisionSecond is a date with hours, minutes and seconds
+	PrecisionSecond
+)
+
+// ParsePrecision parses a precision name: day, minute or second
+func ParsePrecision(name string) (Precision, error) {
+	switch name {
+	case "day":
+		return PrecisionDay, nil
+	case "minute":
+		return PrecisionMinute, nil
+	case "second":
+		return PrecisionSecond, nil
+	}
+	return 0, fmt.Errorf("unknown precision %q (valid: day, minute, second)", name)
+}
+
+// Layout returns the Format layout that writes a date and time to precision p,
+// e.g. "YYYY-MM-DD HH:mm" for PrecisionMinute
+func (p Precision) Layout() string {
+	switch p {
+	case PrecisionMinute:
+		return "YYYY-MM-DD HH:mm"
+	case PrecisionSecond:
+		return "YYYY-MM-DD HH:mm:ss"
+	default:
+		return "YYYY-MM-DD"
+	}
+}
+
+// ParseJalaliTime parses a Jalali date optionally followed by a time of day, as in
+// "1403-05-12", "1403-05-12 14:30" or "1403-05-12T14:30:05", and returns that
+// moment in loc along with how precise s was. A date alone is taken at midnight.
+func ParseJalaliTime(s string, loc *time.Location) (time.Time, Precision, error) {
+	date, clock, precision, err := splitDateTime(s)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+
+	d, err := ParseJalali(date)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+	gy, gm, gd := JalaliToGregorian(d.Year, d.Month, d.Day)
+	return time.Date(gy, time.Month(gm), gd, clock[0], clock[1], clock[2], 0, loc), precision, nil
+}
+
+// ParseGregorianTime parses a Gregorian date optionally followed by a time of day,
+// in the forms accepted by ParseJalaliTime, and returns that moment in loc along
+// with how precise s was
+func ParseGregorianTime(s string, loc *time.Location) (time.Time, Precision, error) {
+	date, clock, precision, err := splitDateTime(s)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+
+	t, err := ParseGregorian(date)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), clock[0], clock[1], clock[2], 0, loc), precision, nil
+}
+
+// splitDateTime splits "DATE", "DATE HH:MM" or "DATE HH:MM:SS" (with a space or
+// 'T' between date and time) into the date and its hour, minute and second
+func splitDateTime(s string) (date string, clock [3]int, precision Precision, err error) {
+	s = strings.TrimSpace(s)
+	date, timeOfDay, found := strings.Cut(s, " ")
+	if !found {
+		date, timeOfDay, found = strings.Cut(s, "T")
+	}
+	if !found {
+		return date, clock, PrecisionDay, nil
+	}
+
+	parts := strings.Split(strings.TrimSpace(timeOfDay), ":")
+	if len(parts) != 2 && len(parts) != 3 {
+		return "", clock, 0, fmt.Errorf("invalid time %q, expected HH:MM or HH:MM:SS", timeOfDay)
+	}
+
+	limits := [3]int{23, 59, 59}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || len(part) != 2 || n < 0 || n > limits[i] {
+			return "", clock, 0, fmt.Errorf("invalid time %q, expected HH:MM or HH:MM:SS", timeOfDay)
+		}
+		clock[i] = n
+	}
+
+	precision = PrecisionMinute
+	if len(parts) == 3 {
+		precision = PrecisionSecond
+	}
+	return date, clock, precision, nil
+}
@@ -0,0 +1,121 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseJalaliTime(t *testing.T) {
+	tests := []struct {
+		in        string
+		want      time.Time
+		precision Precision
+		formatted string
+	}{
+		{"1403-05-12", time.Date(2024, 8, 2, 0, 0, 0, 0, time.UTC), PrecisionDay, "1403-05-12"},
+		{"1403-05-12 14:30", time.Date(2024, 8, 2, 14, 30, 0, 0, time.UTC), PrecisionMinute, "1403-05-12 14:30"},
+		{"1403-05-12T14:30:05", time.Date(2024, 8, 2, 14, 30, 5, 0, time.UTC), PrecisionSecond, "1403-05-12 14:30:05"},
+		{" 1403-01-01 00:00 ", time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC), PrecisionMinute, "1403-01-01 00:00"},
+		{"1403-12-30 23:59:59", time.Date(2025, 3, 20, 23, 59, 59, 0, time.UTC), PrecisionSecond, "1403-12-30 23:59:59"},
+	}
+	for _, tt := range tests {
+		got, precision, err := ParseJalaliTime(tt.in, time.UTC)
+		if err != nil {
+			t.Errorf("ParseJalaliTime(%q): %v", tt.in, err)
+			continue
+		}
+		if !got.Equal(tt.want) || precision != tt.precision {
+			t.Errorf("ParseJalaliTime(%q) = %v, %d, want %v, %d", tt.in, got, precision, tt.want, tt.precision)
+		}
+		if s := FormatTime(got, precision.Layout(), LocaleEnglish); s != tt.formatted {
+			t.Errorf("ParseJalaliTime(%q) formats back as %q, want %q", tt.in, s, tt.formatted)
+		}
+	}
+}
+
+func TestParseGregorianTime(t *testing.T) {
+	tests := []struct {
+		in        string
+		want      time.Time
+		precision Precision
+	}{
+		{"2024-08-02", time.Date(2024, 8, 2, 0, 0, 0, 0, time.UTC), PrecisionDay},
+		{"2024-08-02 14:30", time.Date(2024, 8, 2, 14, 30, 0, 0, time.UTC), PrecisionMinute},
+		{"2024-08-02T14:30:05", time.Date(2024, 8, 2, 14, 30, 5, 0, time.UTC), PrecisionSecond},
+	}
+	for _, tt := range tests {
+		got, precision, err := ParseGregorianTime(tt.in, time.UTC)
+		if err != nil {
+			t.Errorf("ParseGregorianTime(%q): %v", tt.in, err)
+			continue
+		}
+		if !got.Equal(tt.want) || precision != tt.precision {
+			t.Errorf("ParseGregorianTime(%q) = %v, %d, want %v, %d", tt.in, got, precision, tt.want, tt.precision)
+		}
+
+		// Converting to Jalali and back keeps the moment
+		back, _, err := ParseJalaliTime(FormatTime(got, precision.Layout(), LocaleEnglish), time.UTC)
+		if err != nil || !back.Equal(got) {
+			t.Errorf("ParseGregorianTime(%q) round trip = %v, %v, want %v", tt.in, back, err, got)
+		}
+	}
+}
+
+func TestParseTimeInLocation(t *testing.T) {
+	tehran := time.FixedZone("IRST", 3*3600+1800)
+	got, _, err := ParseJalaliTime("1403-01-01 00:15", tehran)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2024, 3, 19, 20, 45, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("ParseJalaliTime in Tehran = %v, want %v", got.UTC(), want)
+	}
+}
+
+func TestParseTimeInvalid(t *testing.T) {
+	for _, in := range []string{
+		"1403-05-12 14",
+		"1403-05-12 14:30:05:01",
+		"1403-05-12 24:00",
+		"1403-05-12 14:60",
+		"1403-05-12 14:30:60",
+		"1403-05-12 4:30",
+		"1403-05-12 14:3a",
+		"1403-05-12 -1:30",
+		"1403-13-01 14:30",
+		"1403-05-12x14:30",
+	} {
+		if _, _, err := ParseJalaliTime(in, time.UTC); err == nil {
+			t.Errorf("ParseJalaliTime(%q) succeeded, want an error", in)
+		}
+	}
+	if _, _, err := ParseGregorianTime("2023-02-29 10:00", time.UTC); err == nil {
+		t.Error("ParseGregorianTime accepted 29 February 2023")
+	}
+}
+
+func TestParsePrecision(t *testing.T) {
+	tests := []struct {
+		name   string
+		want   Precision
+		layout string
+	}{
+		{"day", PrecisionDay, "YYYY-MM-DD"},
+		{"minute", PrecisionMinute, "YYYY-MM-DD HH:mm"},
+		{"second", PrecisionSecond, "YYYY-MM-DD HH:mm:ss"},
+	}
+	for _, tt := range tests {
+		got, err := ParsePrecision(tt.name)
+		if err != nil || got != tt.want {
+			t.Errorf("ParsePrecision(%q) = %d, %v, want %d", tt.name, got, err, tt.want)
+		}
+		if layout := got.Layout(); layout != tt.layout {
+			t.Errorf("%s.Layout() = %q, want %q", tt.name, layout, tt.layout)
+		}
+	}
+	for _, name := range []string{"", "hour", "Day"} {
+		if _, err := ParsePrecision(name); err == nil {
+			t.Errorf("ParsePrecision(%q) succeeded, want an error", name)
+		}
+	}
+}
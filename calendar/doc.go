@@ -0,0 +1,28 @@
+// Package calendar converts between the Jalali (Shamsi) and Gregorian calendars
+// and renders Jalali months as terminal tables.
+//
+// # Time zones
+//
+// A Jalali date is a calendar day, so the date functions work on plain year,
+// month and day numbers and never depend on a time zone. Where a time.Time comes
+// in, as with FromTime and FormatTime, its date is read in the time's own
+// location; call t.In(loc) first to see the same instant elsewhere. Now returns
+// today's date in the local time zone and NowIn the date in another one. The
+// current date, used when Options.Today is unset, is read from Options.Clock,
+// which is the system clock in the local time zone unless a FixedClock or another
+// Clock is given.
+//
+// # Concurrency
+//
+// The conversion and calendar functions, such as GregorianToJalali,
+// JalaliToGregorian, GetDayOfWeek and GetMonthCalendar, keep no state between
+// calls and are safe for concurrent use by multiple goroutines. They do not cache
+// results; any cache added to them must be guarded by a mutex or sync.Map.
+//
+// LoadHolidays may run concurrently with the holiday lookups (HolidaysOn,
+// IsHoliday and the rendering functions); the loaded holidays are guarded by a
+// read-write mutex. The render functions only read the Options they are given,
+// so one Options value may be shared between goroutines as long as its slices
+// and Theme are not modified while in use. DefaultTheme and LightTheme are
+// package variables and should not be modified once rendering has started.
+package calendar
@@ -0,0 +1,148 @@
+package calendar
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Recurrence is how often an event repeats
+type Recurrence string
+
+const (
+	// RepeatNone marks an event that happens once
+	RepeatNone Recurrence = ""
+	// RepeatWeekly repeats an event every week on the weekday of its start date
+	RepeatWeekly Recurrence = "weekly"
+	// RepeatMonthly repeats an event every month on the day of its start date.
+	// In months that are too short it falls on the last day of the month.
+	RepeatMonthly Recurrence = "monthly"
+)
+
+// Event is a user defined event, possibly repeating from its start date on
+type Event struct {
+	Name   string
+	Start  JalaliDate
+	Repeat Recurrence
+	// Until is the last date the event may occur on; the zero value repeats forever
+	Until JalaliDate
+}
+
+// Occurrence is a single date an event falls on
+type Occurrence struct {
+	Date JalaliDate
+	Name string
+}
+
+// eventFile is the format of an events data file
+type eventFile struct {
+	Events []struct {
+		Name   string     `json:"name"`
+		Date   string     `json:"date"`
+		Repeat Recurrence `json:"repeat"`
+		Until  string     `json:"until"`
+	} `json:"events"`
+}
+
+// LoadEvents reads events from a JSON data file:
+//
+//	{"events": [{"name": "Standup", "date": "1403-01-04", "repeat": "weekly", "until": "1403-06-31"}]}
+//
+// repeat is optional and may be "weekly" or "monthly"; until is optional.
+func LoadEvents(r io.Reader) ([]Event, error) {
+	var file eventFile
+	if err := json.NewDecoder(r).Decode(&file); err != nil {
+		return nil, fmt.Errorf("invalid events file: %v", err)
+	}
+
+	events := make([]Event, len(file.Events))
+	for i, e := range file.Events {
+		start, err := ParseJalali(e.Date)
+		if err != nil {
+			return nil, fmt.Errorf("event %q: %w", e.Name, err)
+		}
+
+		switch e.Repeat {
+		case RepeatNone, RepeatWeekly, RepeatMonthly:
+		default:
+			return nil, fmt.Errorf("event %q: unknown repeat %q (valid: weekly, monthly)", e.Name, e.Repeat)
+		}
+
+		events[i] = Event{Name: e.Name, Start: start, Repeat: e.Repeat}
+		if e.Until != "" {
+			if events[i].Until, err = ParseJalali(e.Until); err != nil {
+				return nil, fmt.Errorf("event %q: %w", e.Name, err)
+			}
+		}
+	}
+	return events, nil
+}
+
+// ExpandEvents returns the occurrences of the events within r, ordered by date
+func ExpandEvents(events []Event, r DateRange) []Occurrence {
+	var occurrences []Occurrence
+	for _, e := range events {
+		last := r
+		if e.Until != (JalaliDate{}) && e.Until.before(last.End) {
+			last.End = e.Until
+		}
+		if last.End.before(e.Start) {
+			continue
+		}
+
+		for _, date := range e.dates(last) {
+			occurrences = append(occurrences, Occurrence{Date: date, Name: e.Name})
+		}
+	}
+
+	sort.SliceStable(occurrences, func(i, j int) bool {
+		return occurrences[i].Date.before(occurrences[j].Date)
+	})
+	return occurrences
+}
+
+// dates lists the dates within r the event falls on
+func (e Event) dates(r DateRange) []JalaliDate {
+	var dates []JalaliDate
+	switch e.Repeat {
+	case RepeatWeekly:
+		// Step a week at a time from the first occurrence on or after the range start
+		start, end := JalaliToJDN(e.Start), JalaliToJDN(r.End)
+		jdn := start
+		if from := JalaliToJDN(r.Start); from > start {
+			jdn += (from - start + daysInWeek - 1) / daysInWeek * daysInWeek
+		}
+		for ; jdn <= end; jdn += daysInWeek {
+			dates = append(dates, JDNToJalali(jdn))
+		}
+
+	case RepeatMonthly:
+		year, month := r.Start.Year, r.Start.Month
+		for !r.End.before(JalaliDate{Year: year, Month: month, Day: 1}) {
+			// Clamp to the last day of months shorter than the start day
+			date := JalaliDate{Year: year, Month: month, Day: min(e.Start.Day, GetDaysInMonth(year, month))}
+			if !date.before(e.Start) && r.Contains(date) {
+				dates = append(dates, date)
+			}
+
+			if month++; month > monthsInYear {
+				year, month = year+1, 1
+			}
+		}
+
+	default:
+		if r.Contains(e.Start) {
+			dates = append(dates, e.Start)
+		}
+	}
+	return dates
+}
+
+// monthRange returns the range covering every day of a month
+func monthRange(year, month int) DateRange {
+	return DateRange{
+		Start: JalaliDate{Year: year, Month: month, Day: 1},
+		End:   JalaliDate{Year: year, Month: month, Day: GetDaysInMonth(year, month)},
+	}
+}
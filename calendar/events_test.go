@@ -0,0 +1,113 @@
+package calendar
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// occurrenceDates returns the dates of occurrences written as YYYY-MM-DD
+func occurrenceDates(occurrences []Occurrence) string {
+	dates := make([]string, len(occurrences))
+	for i, o := range occurrences {
+		dates[i] = o.Date.String()
+	}
+	return strings.Join(dates, " ")
+}
+
+func TestExpandEventsMonthlyClamp(t *testing.T) {
+	rent := []Event{{Name: "Rent", Start: JalaliDate{Year: 1403, Month: 1, Day: 31}, Repeat: RepeatMonthly}}
+
+	tests := []struct {
+		year, month int
+		want        string
+	}{
+		{1403, 6, "1403-06-31"},
+		// From Mehr on months have 30 days
+		{1403, 7, "1403-07-30"},
+		// Esfand has 30 days in the leap year 1403 and 29 in 1404
+		{1403, 12, "1403-12-30"},
+		{1404, 12, "1404-12-29"},
+	}
+	for _, tt := range tests {
+		if got := occurrenceDates(ExpandEvents(rent, monthRange(tt.year, tt.month))); got != tt.want {
+			t.Errorf("%d-%02d: occurrences %q, want %q", tt.year, tt.month, got, tt.want)
+		}
+	}
+
+	// Across a year each month has a single occurrence, none before the start
+	r := DateRange{Start: JalaliDate{Year: 1402, Month: 7, Day: 1}, End: JalaliDate{Year: 1403, Month: 12, Day: 30}}
+	got := ExpandEvents(rent, r)
+	if len(got) != 12 || got[0].Date != rent[0].Start {
+		t.Errorf("occurrences %q, want one a month from %v", occurrenceDates(got), rent[0].Start)
+	}
+}
+
+func TestExpandEventsWeekly(t *testing.T) {
+	// 1 Mordad 1403 is a Doshanbe
+	start := JalaliDate{Year: 1403, Month: 5, Day: 1}
+	weekly := Event{Name: "Standup", Start: start, Repeat: RepeatWeekly}
+	until := weekly
+	until.Until = JalaliDate{Year: 1403, Month: 5, Day: 29}
+
+	tests := []struct {
+		name  string
+		event Event
+		r     DateRange
+		want  string
+	}{
+		{"month", weekly, monthRange(1403, 5), "1403-05-01 1403-05-08 1403-05-15 1403-05-22 1403-05-29"},
+		{"range starting between occurrences", weekly,
+			DateRange{Start: JalaliDate{Year: 1403, Month: 5, Day: 10}, End: JalaliDate{Year: 1403, Month: 6, Day: 10}},
+			"1403-05-15 1403-05-22 1403-05-29 1403-06-05"},
+		{"until", until, DateRange{Start: JalaliDate{Year: 1403, Month: 5, Day: 20}, End: JalaliDate{Year: 1403, Month: 6, Day: 31}},
+			"1403-05-22 1403-05-29"},
+		{"before the start", weekly, monthRange(1403, 4), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExpandEvents([]Event{tt.event}, tt.r)
+			if dates := occurrenceDates(got); dates != tt.want {
+				t.Errorf("occurrences %q, want %q", dates, tt.want)
+			}
+			for _, o := range got {
+				if weekday := GetDayOfWeek(o.Date.Year, o.Date.Month, o.Date.Day); weekday != 2 {
+					t.Errorf("%v falls on weekday %d, want Doshanbe", o.Date, weekday)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadEvents(t *testing.T) {
+	events, err := LoadEvents(strings.NewReader(`{"events": [
+		{"name": "Standup", "date": "1403-01-04", "repeat": "weekly", "until": "1403-06-31"},
+		{"name": "Birthday", "date": "1403/05/12"}
+	]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Event{
+		{Name: "Standup", Start: JalaliDate{Year: 1403, Month: 1, Day: 4}, Repeat: RepeatWeekly, Until: JalaliDate{Year: 1403, Month: 6, Day: 31}},
+		{Name: "Birthday", Start: JalaliDate{Year: 1403, Month: 5, Day: 12}},
+	}
+	if fmt.Sprint(events) != fmt.Sprint(want) {
+		t.Errorf("LoadEvents = %v, want %v", events, want)
+	}
+
+	invalid := []struct{ name, data, want string }{
+		{"malformed JSON", `{"events": [`, "invalid events file"},
+		{"wrong type", `{"events": {}}`, "invalid events file"},
+		{"bad date", `{"events": [{"name": "Trip", "date": "1403-13-01"}]}`, `event "Trip"`},
+		{"unknown repeat", `{"events": [{"name": "Gym", "date": "1403-01-01", "repeat": "daily"}]}`, `unknown repeat "daily"`},
+		{"bad until", `{"events": [{"name": "Gym", "date": "1403-01-01", "repeat": "weekly", "until": "soon"}]}`, `event "Gym"`},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := LoadEvents(strings.NewReader(tt.data))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("LoadEvents = %v, %v, want an error containing %q", events, err, tt.want)
+			}
+		})
+	}
+}
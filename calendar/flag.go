@@ -0,0 +1,93 @@
+package calendar
+
+import "strings"
+
+// JalaliDateValue is a JalaliDate usable as a command line flag. It implements
+// flag.Value and pflag.Value, parsing dates with ParseJalali:
+//
+//	cmd.Flags().Var((*calendar.JalaliDateValue)(&date), "from", "start date")
+type JalaliDateValue JalaliDate
+
+// Set parses a date written as YYYY-MM-DD or YYYY/MM/DD
+func (v *JalaliDateValue) Set(s string) error {
+	return (*JalaliDate)(v).UnmarshalText([]byte(s))
+}
+
+// String returns the date in ISO form, or "" when it is unset
+func (v *JalaliDateValue) String() string {
+	if v == nil || *v == (JalaliDateValue{}) {
+		return ""
+	}
+	return JalaliDate(*v).String()
+}
+
+// Type names the value in pflag's help output
+func (v *JalaliDateValue) Type() string {
+	return "date"
+}
+
+// JalaliDateListValue is a list of dates usable as a repeatable command line flag.
+// Each use appends its comma separated dates, e.g. --highlight 1403-01-01,1403-01-13.
+type JalaliDateListValue []JalaliDate
+
+// Set parses and appends comma separated dates
+func (v *JalaliDateListValue) Set(s string) error {
+	for _, part := range strings.Split(s, ",") {
+		date, err := ParseJalali(part)
+		if err != nil {
+			return err
+		}
+		*v = append(*v, date)
+	}
+	return nil
+}
+
+// String returns the dates separated by commas
+func (v *JalaliDateListValue) String() string {
+	if v == nil {
+		return ""
+	}
+	dates := make([]string, len(*v))
+	for i, date := range *v {
+		dates[i] = date.String()
+	}
+	return strings.Join(dates, ",")
+}
+
+// Type names the value in pflag's help output
+func (v *JalaliDateListValue) Type() string {
+	return "dates"
+}
+
+// DateRangeListValue is a list of date ranges usable as a repeatable command line
+// flag. Each use appends its comma separated ranges written as START..END.
+type DateRangeListValue []DateRange
+
+// Set parses and appends comma separated ranges
+func (v *DateRangeListValue) Set(s string) error {
+	for _, part := range strings.Split(s, ",") {
+		r, err := ParseDateRange(part)
+		if err != nil {
+			return err
+		}
+		*v = append(*v, r)
+	}
+	return nil
+}
+
+// String returns the ranges as START..END separated by commas
+func (v *DateRangeListValue) String() string {
+	if v == nil {
+		return ""
+	}
+	ranges := make([]string, len(*v))
+	for i, r := range *v {
+		ranges[i] = r.Start.String() + ".." + r.End.String()
+	}
+	return strings.Join(ranges, ",")
+}
+
+// Type names the value in pflag's help output
+func (v *DateRangeListValue) Type() string {
+	return "ranges"
+}
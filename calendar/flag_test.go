@@ -0,0 +1,90 @@
+package calendar
+
+import (
+	"errors"
+	"flag"
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+// The date flags satisfy both the standard library and the pflag interfaces
+var (
+	_ flag.Value  = (*JalaliDateValue)(nil)
+	_ pflag.Value = (*JalaliDateValue)(nil)
+	_ pflag.Value = (*JalaliDateListValue)(nil)
+	_ pflag.Value = (*DateRangeListValue)(nil)
+)
+
+func TestJalaliDateValue(t *testing.T) {
+	var date JalaliDate
+	v := (*JalaliDateValue)(&date)
+	if v.String() != "" || v.Type() != "date" {
+		t.Errorf("unset value: String() = %q, Type() = %q", v.String(), v.Type())
+	}
+
+	if err := v.Set("1403/05/12"); err != nil {
+		t.Fatal(err)
+	}
+	if date != (JalaliDate{1403, 5, 12}) || v.String() != "1403-05-12" {
+		t.Errorf("after Set: date %v, String() = %q", date, v.String())
+	}
+
+	if err := v.Set("1403-13-01"); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Set(1403-13-01) = %v, want ErrOutOfRange", err)
+	}
+	if date != (JalaliDate{1403, 5, 12}) {
+		t.Errorf("a failed Set changed the date to %v", date)
+	}
+}
+
+func TestJalaliDateListValue(t *testing.T) {
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	var dates []JalaliDate
+	fs.Var((*JalaliDateListValue)(&dates), "highlight", "")
+
+	if err := fs.Parse([]string{"--highlight", "1403-01-01,1403-01-13", "--highlight", "1403-12-30"}); err != nil {
+		t.Fatal(err)
+	}
+	want := []JalaliDate{{1403, 1, 1}, {1403, 1, 13}, {1403, 12, 30}}
+	if len(dates) != len(want) {
+		t.Fatalf("parsed %v, want %v", dates, want)
+	}
+	for i := range want {
+		if dates[i] != want[i] {
+			t.Errorf("date %d is %v, want %v", i, dates[i], want[i])
+		}
+	}
+
+	f := fs.Lookup("highlight")
+	if got := f.Value.String(); got != "1403-01-01,1403-01-13,1403-12-30" {
+		t.Errorf("String() = %q", got)
+	}
+	if got := f.Value.Type(); got != "dates" {
+		t.Errorf("Type() = %q, want dates", got)
+	}
+	if err := f.Value.Set("1403-01-01,tomorrow"); err == nil {
+		t.Error("Set accepted an invalid date")
+	}
+}
+
+func TestDateRangeListValue(t *testing.T) {
+	var ranges []DateRange
+	v := (*DateRangeListValue)(&ranges)
+
+	if err := v.Set("1403-01-01..1403-01-04,1403-12-25..1404-01-05"); err != nil {
+		t.Fatal(err)
+	}
+	if got := v.String(); got != "1403-01-01..1403-01-04,1403-12-25..1404-01-05" {
+		t.Errorf("String() = %q", got)
+	}
+	if v.Type() != "ranges" {
+		t.Errorf("Type() = %q, want ranges", v.Type())
+	}
+
+	for _, invalid := range []string{"1403-01-05..1403-01-01", "1403-01-01", "1403-01-01..1403-13-01"} {
+		if err := v.Set(invalid); err == nil {
+			t.Errorf("Set(%q) was accepted", invalid)
+		}
+	}
+}
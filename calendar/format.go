@@ -0,0 +1,150 @@
+package calendar
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// FromTime returns the Jalali date of t, taken in t's own location: 00:30 on
+// 1 Farvardin in Tehran is still Esfand in UTC. Use t.In(loc) to convert the
+// same instant for another time zone.
+func FromTime(t time.Time) JalaliDate {
+	return GregorianToJalali(t.Year(), int(t.Month()), t.Day())
+}
+
+// formatTokens are the layout tokens understood by Format and FormatTime,
+// longest first so that "MMMM" is not read as two "MM"
+var formatTokens = []string{"YYYY", "MMMM", "dddd", "YY", "MM", "DD", "HH", "mm", "ss", "zz", "M", "D"}
+
+// Format returns d written according to layout, in which these tokens are replaced:
+//
+//	YYYY  year, e.g. 1403       YY  two digit year, e.g. 03
+//	MMMM  month name, Mordad    MM  month, 05    M  month, 5
+//	DD    day, 07               D   day, 7
+//	dddd  weekday name, Se
+//
+// Any other text is copied as is. Names are transliterated; see FormatTime for
+// other locales and for the time of day.
+func (d JalaliDate) Format(layout string) string {
+	return formatDate(layout, d, nil, LocaleTransliterated)
+}
+
+// FormatTime returns the Jalali date and time of t, in t's location, written
+// according to layout. Besides the tokens of Format it replaces HH (hour, 00-23),
+// mm (minute), ss (second) and zz (time zone abbreviation, e.g. IRST). Month and
+// weekday names are written in locale.
+func FormatTime(t time.Time, layout string, locale Locale) string {
+	return formatDate(layout, FromTime(t), &t, locale)
+}
+
+// TimeToJalaliString returns the Jalali date and time of t, in t's location, written
+// according to layout with transliterated names. It is a shorthand for FormatTime
+// with LocaleTransliterated, e.g. "YYYY-MM-DD HH:mm" gives "1403-05-12 14:30".
+func TimeToJalaliString(t time.Time, layout string) string {
+	return FormatTime(t, layout, LocaleTransliterated)
+}
+
+// FormatGregorian returns the Gregorian date and time of t written according to
+// layout, using the same tokens as FormatTime. Month and weekday names are in English.
+func FormatGregorian(t time.Time, layout string) string {
+	return expandLayout(layout, func(token string) (string, bool) {
+		switch token {
+		case "YYYY":
+			return strconv.Itoa(t.Year()), true
+		case "YY":
+			return fmt.Sprintf("%02d", t.Year()%100), true
+		case "MMMM":
+			return t.Month().String(), true
+		case "MM":
+			return fmt.Sprintf("%02d", int(t.Month())), true
+		case "M":
+			return strconv.Itoa(int(t.Month())), true
+		case "DD":
+			return fmt.Sprintf("%02d", t.Day()), true
+		case "D":
+			return strconv.Itoa(t.Day()), true
+		case "dddd":
+			return t.Weekday().String(), true
+		}
+		return formatClockToken(token, t)
+	})
+}
+
+// formatDate expands the tokens of layout. Time tokens are only replaced when t is set.
+func formatDate(layout string, d JalaliDate, t *time.Time, locale Locale) string {
+	return expandLayout(layout, func(token string) (string, bool) {
+		return formatToken(token, d, t, locale)
+	})
+}
+
+// expandLayout replaces each token of layout by its value, copying other text as is
+func expandLayout(layout string, value func(token string) (string, bool)) string {
+	out := &strings.Builder{}
+	for len(layout) > 0 {
+		token := ""
+		for _, tok := range formatTokens {
+			if strings.HasPrefix(layout, tok) {
+				token = tok
+				break
+			}
+		}
+
+		text, ok := value(token)
+		if !ok {
+			// Copy a single character, which may be multi-byte
+			_, size := utf8.DecodeRuneInString(layout)
+			out.WriteString(layout[:size])
+			layout = layout[size:]
+			continue
+		}
+		out.WriteString(text)
+		layout = layout[len(token):]
+	}
+	return out.String()
+}
+
+// formatToken returns the value of a layout token and whether token is one
+func formatToken(token string, d JalaliDate, t *time.Time, locale Locale) (string, bool) {
+	switch token {
+	case "YYYY":
+		return strconv.Itoa(d.Year), true
+	case "YY":
+		return fmt.Sprintf("%02d", d.Year%100), true
+	case "MMMM":
+		return locale.MonthName(d.Month), true
+	case "MM":
+		return fmt.Sprintf("%02d", d.Month), true
+	case "M":
+		return strconv.Itoa(d.Month), true
+	case "DD":
+		return fmt.Sprintf("%02d", d.Day), true
+	case "D":
+		return strconv.Itoa(d.Day), true
+	case "dddd":
+		return locale.WeekdayName(GetDayOfWeek(d.Year, d.Month, d.Day)), true
+	}
+
+	if t == nil {
+		return "", false
+	}
+	return formatClockToken(token, *t)
+}
+
+// formatClockToken returns the value of a time of day token
+func formatClockToken(token string, t time.Time) (string, bool) {
+	switch token {
+	case "HH":
+		return fmt.Sprintf("%02d", t.Hour()), true
+	case "mm":
+		return fmt.Sprintf("%02d", t.Minute()), true
+	case "ss":
+		return fmt.Sprintf("%02d", t.Second()), true
+	case "zz":
+		zone, _ := t.Zone()
+		return zone, true
+	}
+	return "", false
+}
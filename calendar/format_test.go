@@ -0,0 +1,83 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata" // the tests do not depend on the zone database of the system
+)
+
+func TestFromTimeNearMidnight(t *testing.T) {
+	tehran, err := time.LoadLocation("Asia/Tehran")
+	if err != nil {
+		t.Fatal(err)
+	}
+	losAngeles, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		t    time.Time
+		want JalaliDate
+	}{
+		{"just after midnight in Tehran", time.Date(2024, 3, 20, 0, 30, 0, 0, tehran), JalaliDate{1403, 1, 1}},
+		{"the same instant in UTC", time.Date(2024, 3, 20, 0, 30, 0, 0, tehran).UTC(), JalaliDate{1402, 12, 29}},
+		{"just before midnight in Tehran", time.Date(2024, 3, 19, 23, 59, 59, 0, tehran), JalaliDate{1402, 12, 29}},
+		{"evening in Los Angeles", time.Date(2024, 3, 19, 20, 0, 0, 0, losAngeles), JalaliDate{1402, 12, 29}},
+		{"the same instant in Tehran", time.Date(2024, 3, 19, 20, 0, 0, 0, losAngeles).In(tehran), JalaliDate{1403, 1, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromTime(tt.t); got != tt.want {
+				t.Errorf("FromTime(%v) = %v, want %v", tt.t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatTimeUsesItsLocation(t *testing.T) {
+	tehran, err := time.LoadLocation("Asia/Tehran")
+	if err != nil {
+		t.Fatal(err)
+	}
+	instant := time.Date(2024, 3, 19, 21, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		t    time.Time
+		want string
+	}{
+		{instant, "1402-12-29 21:00 Se"},
+		{instant.In(tehran), "1403-01-01 00:30 Chahar"},
+	}
+	for _, tt := range tests {
+		if got := FormatTime(tt.t, "YYYY-MM-DD HH:mm dddd", LocaleTransliterated); got != tt.want {
+			t.Errorf("FormatTime(%v) = %q, want %q", tt.t, got, tt.want)
+		}
+	}
+}
+
+func TestTimeToJalaliString(t *testing.T) {
+	tehran, err := time.LoadLocation("Asia/Tehran")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 21:00 UTC on 19 March 2024 is the last evening of 1402 in UTC and already Nowruz 1403 in Tehran
+	instant := time.Date(2024, 3, 19, 21, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		t      time.Time
+		layout string
+		want   string
+	}{
+		{instant, "YYYY-MM-DD HH:mm:ss zz", "1402-12-29 21:00:00 UTC"},
+		{instant.In(tehran), "YYYY-MM-DD HH:mm:ss zz", "1403-01-01 00:30:00 +0330"},
+		{instant, "dddd D MMMM YY", "Se 29 Esfand 02"},
+		{instant.In(tehran), "dddd D MMMM YY", "Chahar 1 Farvardin 03"},
+	}
+	for _, tt := range tests {
+		if got := TimeToJalaliString(tt.t, tt.layout); got != tt.want {
+			t.Errorf("TimeToJalaliString(%v, %q) = %q, want %q", tt.t, tt.layout, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package calendar
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Holiday is an official holiday on a day of the Jalali year
+type Holiday struct {
+	Month int    `json:"month"`
+	Day   int    `json:"day"`
+	Name  string `json:"name"`
+	// PersianName is the name in Persian script; it may be empty
+	PersianName string `json:"name_fa,omitempty"`
+}
+
+// LocalName returns the name of the holiday in a locale, falling back to
+// Name when the holiday has no Persian name
+func (h Holiday) LocalName(locale Locale) string {
+	if locale == LocalePersian && h.PersianName != "" {
+		return h.PersianName
+	}
+	return h.Name
+}
+
+// solarHolidays are the official holidays fixed on the solar calendar.
+// They fall on the same Jalali date every year.
+var solarHolidays = []Holiday{
+	{Month: 1, Day: 1, Name: "Nowruz", PersianName: "نوروز"},
+	{Month: 1, Day: 2, Name: "Nowruz", PersianName: "نوروز"},
+	{Month: 1, Day: 3, Name: "Nowruz", PersianName: "نوروز"},
+	{Month: 1, Day: 4, Name: "Nowruz", PersianName: "نوروز"},
+	{Month: 1, Day: 12, Name: "Islamic Republic Day", PersianName: "روز جمهوری اسلامی"},
+	{Month: 1, Day: 13, Name: "Sizdah Bedar", PersianName: "سیزده به در"},
+	{Month: 3, Day: 14, Name: "Demise of Imam Khomeini", PersianName: "رحلت امام خمینی"},
+	{Month: 3, Day: 15, Name: "15 Khordad Uprising", PersianName: "قیام ۱۵ خرداد"},
+	{Month: 11, Day: 22, Name: "Islamic Revolution Day", PersianName: "پیروزی انقلاب اسلامی"},
+	{Month: 12, Day: 29, Name: "Oil Nationalization Day", PersianName: "ملی شدن صنعت نفت"},
+}
+
+// lunarHolidays holds the year specific holidays loaded from data files, keyed by Jalali year.
+// Religious holidays follow the lunar calendar and move every year, so they are only
+// known for the years a data file has been loaded for.
+var (
+	lunarHolidaysMu sync.RWMutex
+	lunarHolidays   = map[int][]Holiday{}
+)
+
+// holidayFile is the format of a yearly holiday data file
+type holidayFile struct {
+	Year     int       `json:"year"`
+	Holidays []Holiday `json:"holidays"`
+}
+
+// LoadHolidays reads a yearly holiday data file in JSON format and registers its holidays:
+//
+//	{"year": 1403, "holidays": [{"month": 1, "day": 22, "name": "Eid al-Fitr", "name_fa": "عید فطر"}]}
+//
+// name_fa is optional.
+// Loading a file for a year that is already loaded adds to its holidays.
+func LoadHolidays(r io.Reader) error {
+	var file holidayFile
+	if err := json.NewDecoder(r).Decode(&file); err != nil {
+		return fmt.Errorf("invalid holidays file: %v", err)
+	}
+
+	for _, h := range file.Holidays {
+		days, err := DaysInMonth(file.Year, h.Month)
+		if err != nil {
+			return fmt.Errorf("holiday %q: %w", h.Name, err)
+		}
+		if h.Day < 1 || h.Day > days {
+			return fmt.Errorf("holiday %q: day %d: %w", h.Name, h.Day, ErrOutOfRange)
+		}
+	}
+
+	lunarHolidaysMu.Lock()
+	defer lunarHolidaysMu.Unlock()
+	lunarHolidays[file.Year] = append(lunarHolidays[file.Year], file.Holidays...)
+	return nil
+}
+
+// HolidaysOn returns the holidays falling on a date, combining the fixed solar
+// holidays with any loaded year specific ones
+func HolidaysOn(d JalaliDate) []Holiday {
+	var holidays []Holiday
+	for _, h := range solarHolidays {
+		if h.Month == d.Month && h.Day == d.Day {
+			holidays = append(holidays, h)
+		}
+	}
+
+	lunarHolidaysMu.RLock()
+	defer lunarHolidaysMu.RUnlock()
+	for _, h := range lunarHolidays[d.Year] {
+		if h.Month == d.Month && h.Day == d.Day {
+			holidays = append(holidays, h)
+		}
+	}
+	return holidays
+}
+
+// YearHolidays returns every holiday of a year, the fixed solar holidays and any
+// loaded year specific ones, ordered by date
+func YearHolidays(year int) []Holiday {
+	holidays := append([]Holiday{}, solarHolidays...)
+
+	lunarHolidaysMu.RLock()
+	holidays = append(holidays, lunarHolidays[year]...)
+	lunarHolidaysMu.RUnlock()
+
+	sort.SliceStable(holidays, func(i, j int) bool {
+		if holidays[i].Month != holidays[j].Month {
+			return holidays[i].Month < holidays[j].Month
+		}
+		return holidays[i].Day < holidays[j].Day
+	})
+	return holidays
+}
+
+// SearchHolidays returns the holidays of a year whose English or Persian name
+// contains query, ignoring case and spaces, ordered by date
+func SearchHolidays(year int, query string) []Holiday {
+	var matches []Holiday
+	for _, h := range YearHolidays(year) {
+		if MatchName(h.Name, query) || MatchName(h.PersianName, query) {
+			matches = append(matches, h)
+		}
+	}
+	return matches
+}
+
+// MatchName reports whether name contains query, ignoring case, spaces and the
+// different forms of the Persian letters ye and kaf
+func MatchName(name, query string) bool {
+	simplify := func(s string) string {
+		s = strings.NewReplacer(" ", "", "\u200c", "", "-", "").Replace(normalizePersian(s))
+		return strings.ToLower(s)
+	}
+	query = simplify(query)
+	return query != "" && strings.Contains(simplify(name), query)
+}
+
+// IsHoliday reports whether a date is an official holiday
+func IsHoliday(d JalaliDate) bool {
+	return len(HolidaysOn(d)) > 0
+}
+
+// MonthHolidays returns the days of a month that are official holidays
+func MonthHolidays(year, month int) []int {
+	days := []int{}
+	for day := 1; day <= GetDaysInMonth(year, month); day++ {
+		if IsHoliday(JalaliDate{Year: year, Month: month, Day: day}) {
+			days = append(days, day)
+		}
+	}
+	return days
+}
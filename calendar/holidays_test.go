@@ -0,0 +1,74 @@
+package calendar
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestMatchName(t *testing.T) {
+	tests := []struct {
+		name, query string
+		want        bool
+	}{
+		{"Islamic Republic Day", "republic", true},
+		{"Islamic Republic Day", "REPUBLIC DAY", true},
+		{"Islamic Republic Day", "islamicrepublic", true},
+		{"Eid al-Fitr", "al fitr", true},
+		{"Eid al-Fitr", "alfitr", true},
+		// the zero-width non-joiner and the Arabic forms of ye and kaf are ignored
+		{"سیزده به در", "سیزده‌به‌در", true},
+		{"روز جمهوری اسلامی", "جمهوري", true},
+		{"ملی شدن صنعت نفت", "صنعت", true},
+		{"Nowruz", "eid", false},
+		{"Nowruz", "", false},
+		{"Nowruz", " ", false},
+	}
+	for _, tt := range tests {
+		if got := MatchName(tt.name, tt.query); got != tt.want {
+			t.Errorf("MatchName(%q, %q) = %v, want %v", tt.name, tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestSearchHolidays(t *testing.T) {
+	// 1390 is not loaded by any other test
+	const year = 1390
+	file := `{"year": 1390, "holidays": [
+		{"month": 6, "day": 16, "name": "Eid al-Adha", "name_fa": "عید قربان"},
+		{"month": 5, "day": 9, "name": "Eid al-Fitr", "name_fa": "عید فطر"}
+	]}`
+	if err := LoadHolidays(strings.NewReader(file)); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		lunarHolidaysMu.Lock()
+		delete(lunarHolidays, year)
+		lunarHolidaysMu.Unlock()
+	})
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"nowruz", []string{"1-1", "1-2", "1-3", "1-4"}},
+		{"day", []string{"1-12", "11-22", "12-29"}},
+		{"eid", []string{"5-9", "6-16"}},
+		{"عيد", []string{"5-9", "6-16"}},
+		{"قربان", []string{"6-16"}},
+		{"christmas", nil},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, h := range SearchHolidays(year, tt.query) {
+			got = append(got, fmt.Sprintf("%d-%d", h.Month, h.Day))
+		}
+		if strings.Join(got, " ") != strings.Join(tt.want, " ") {
+			t.Errorf("SearchHolidays(%d, %q) = %v, want %v", year, tt.query, got, tt.want)
+		}
+	}
+
+	if got := SearchHolidays(year+1, "eid"); len(got) != 0 {
+		t.Errorf("SearchHolidays(%d, \"eid\") = %v, want the holidays of %d only", year+1, got, year)
+	}
+}
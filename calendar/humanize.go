@@ -0,0 +1,79 @@
+package calendar
+
+import (
+	"fmt"
+	"math"
+)
+
+// Average lengths used to round an interval to weeks, months and years. A
+// Jalali year follows the tropical year, and its months average a twelfth of it.
+const (
+	daysPerYear  = 365.2422
+	daysPerMonth = daysPerYear / monthsInYear
+)
+
+// intervalUnit names a unit of time in the singular and plural, in English and Persian
+type intervalUnit struct {
+	one, many, persian string
+}
+
+var (
+	unitDay   = intervalUnit{"day", "days", "روز"}
+	unitWeek  = intervalUnit{"week", "weeks", "هفته"}
+	unitMonth = intervalUnit{"month", "months", "ماه"}
+	unitYear  = intervalUnit{"year", "years", "سال"}
+)
+
+// HumanizeInterval describes an interval of days from today in words, as in
+// "in 3 days", "2 months ago", "yesterday" or, with LocalePersian, "3 روز دیگر".
+// Up to 13 days are counted in days and up to 45 in weeks; longer intervals are
+// rounded to the nearest month, and from about eleven and a half months on to the
+// nearest year, using the average lengths of the Jalali month and year.
+func HumanizeInterval(days int, locale Locale) string {
+	switch days {
+	case 0:
+		return localePhrase(locale, "today", "امروز")
+	case 1:
+		return localePhrase(locale, "tomorrow", "فردا")
+	case -1:
+		return localePhrase(locale, "yesterday", "دیروز")
+	}
+
+	span := math.Abs(float64(days))
+	var n int
+	var unit intervalUnit
+	switch {
+	case span < 14:
+		n, unit = int(span), unitDay
+	case span < 46:
+		n, unit = int(math.Round(span/daysInWeek)), unitWeek
+	case math.Round(span/daysPerMonth) < monthsInYear:
+		n, unit = int(math.Round(span/daysPerMonth)), unitMonth
+	default:
+		n, unit = int(math.Max(1, math.Round(span/daysPerYear))), unitYear
+	}
+
+	if locale == LocalePersian {
+		if days < 0 {
+			return fmt.Sprintf("%d %s پیش", n, unit.persian)
+		}
+		return fmt.Sprintf("%d %s دیگر", n, unit.persian)
+	}
+
+	name := unit.many
+	if n == 1 {
+		name = unit.one
+	}
+	if days < 0 {
+		return fmt.Sprintf("%d %s ago", n, name)
+	}
+	return fmt.Sprintf("in %d %s", n, name)
+}
+
+// localePhrase picks the Persian or the English form of a phrase
+func localePhrase(locale Locale, english, persian string) string {
+	if locale == LocalePersian {
+		return persian
+	}
+	return english
+}
@@ -0,0 +1,41 @@
+package calendar
+
+import "testing"
+
+func TestHumanizeInterval(t *testing.T) {
+	tests := []struct {
+		days             int
+		english, persian string
+	}{
+		{0, "today", "امروز"},
+		{1, "tomorrow", "فردا"},
+		{-1, "yesterday", "دیروز"},
+		{2, "in 2 days", "2 روز دیگر"},
+		{-2, "2 days ago", "2 روز پیش"},
+		{13, "in 13 days", "13 روز دیگر"},
+		// from two weeks on, intervals are rounded to weeks
+		{14, "in 2 weeks", "2 هفته دیگر"},
+		{-14, "2 weeks ago", "2 هفته پیش"},
+		{45, "in 6 weeks", "6 هفته دیگر"},
+		// and from 46 days to the nearest month, here 1.51 months
+		{46, "in 2 months", "2 ماه دیگر"},
+		{-46, "2 months ago", "2 ماه پیش"},
+		{350, "in 11 months", "11 ماه دیگر"},
+		// 351 days round to twelve months, which is shown as a year
+		{351, "in 1 year", "1 سال دیگر"},
+		{-365, "1 year ago", "1 سال پیش"},
+		{547, "in 1 year", "1 سال دیگر"},
+		{548, "in 2 years", "2 سال دیگر"},
+		{-3653, "10 years ago", "10 سال پیش"},
+	}
+	for _, tt := range tests {
+		for _, locale := range []Locale{LocaleTransliterated, LocaleEnglish} {
+			if got := HumanizeInterval(tt.days, locale); got != tt.english {
+				t.Errorf("HumanizeInterval(%d, %d) = %q, want %q", tt.days, locale, got, tt.english)
+			}
+		}
+		if got := HumanizeInterval(tt.days, LocalePersian); got != tt.persian {
+			t.Errorf("HumanizeInterval(%d, LocalePersian) = %q, want %q", tt.days, got, tt.persian)
+		}
+	}
+}
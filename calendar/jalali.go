@@ -1,22 +1,34 @@
 package calendar
 
 import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
 const (
 	gregorianOffset = 621
-	julianDayOffset = 79
-	cycle33Years = 12053
 	cycle4Years = 1461
 	cycle400Years = 146097
-	cycle100Years = 36524
-	cycle4YearsIn100 = 1461
 	firstHalfDays = 186 // 6 * 31
 	esfandMonth = 12
 	leapYearIndicator = 0
 )
 
+// MinYear and MaxYear bound the supported Jalali years. MaxYear is the last year
+// before the final entry of breaks, the range the jalaali-js algorithm is defined
+// for. Every intermediate value of the conversions stays far below 2^31 for these
+// years, so they give the same results on 32-bit platforms, where int is 32 bits wide.
+const (
+	MinYear = 1
+	MaxYear = 3177
+)
+
+// ErrOutOfRange is returned when a date component falls outside the supported range
+var ErrOutOfRange = errors.New("date out of range")
+
 type JalaliDate struct {
 	Year  int
 	Month int
@@ -29,11 +41,8 @@ var daysInMonth = []int{31, 31, 31, 31, 31, 31, 30, 30, 30, 30, 30, 29}
 // These years mark boundaries where the leap year pattern changes
 var breaks = []int{-61, 9, 38, 199, 426, 686, 756, 818, 1111, 1181, 1210, 1635, 2060, 2097, 2192, 2262, 2324, 2394, 2456, 3178}
 
-// Gregorian month day offsets (non-leap year)
-var gregorianMonthOffsets = [...]int{0, 31, 59, 90, 120, 151, 181, 212, 243, 273, 304, 334}
-
-// Gregorian month day offsets (leap year)
-var gregorianMonthOffsetsLeap = [...]int{0, 31, 60, 91, 121, 152, 182, 213, 244, 274, 305, 335}
+// Gregorian month lengths (non-leap year)
+var gregorianMonthDays = [...]int{31, 28, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
 
 // jalCalResult holds the result of Jalali calendar calculations
 type jalCalResult struct {
@@ -47,12 +56,188 @@ func div(a, b int) int {
 	return int(a / b)
 }
 
+// String returns the date in ISO form, e.g. "1403-05-12"
+func (d JalaliDate) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// AddDays returns the date n days after d; n may be negative
+func (d JalaliDate) AddDays(n int) JalaliDate {
+	return JDNToJalali(JalaliToJDN(d) + n)
+}
+
+// AddWeeks returns the date n weeks after d; n may be negative
+func (d JalaliDate) AddWeeks(n int) JalaliDate {
+	return d.AddDays(n * daysInWeek)
+}
+
+// StartOfWeek returns the first day of the week containing d, for weeks starting
+// on weekStart (0=Shanbe ... 6=Jome). The result may fall in the previous month or year.
+func (d JalaliDate) StartOfWeek(weekStart int) JalaliDate {
+	weekday := GetDayOfWeek(d.Year, d.Month, d.Day)
+	return d.AddDays(-((weekday - weekStart + daysInWeek) % daysInWeek))
+}
+
+// EndOfWeek returns the last day of the week containing d, for weeks starting
+// on weekStart (0=Shanbe ... 6=Jome). The result may fall in the next month or year.
+func (d JalaliDate) EndOfWeek(weekStart int) JalaliDate {
+	return d.StartOfWeek(weekStart).AddDays(daysInWeek - 1)
+}
+
+// TruncateToMonth returns the first day of d's month
+func (d JalaliDate) TruncateToMonth() JalaliDate {
+	return JalaliDate{Year: d.Year, Month: d.Month, Day: 1}
+}
+
+// TruncateToQuarter returns the first day of d's quarter, which is also its
+// season: 1 Farvardin, 1 Tir, 1 Mehr or 1 Dey
+func (d JalaliDate) TruncateToQuarter() JalaliDate {
+	return JalaliDate{Year: d.Year, Month: (d.Month-1)/monthsInQuarter*monthsInQuarter + 1, Day: 1}
+}
+
+// TruncateToYear returns 1 Farvardin of d's year
+func (d JalaliDate) TruncateToYear() JalaliDate {
+	return FirstDayOfYear(d.Year)
+}
+
+// EndOfMonth returns the last day of d's month
+func (d JalaliDate) EndOfMonth() JalaliDate {
+	return JalaliDate{Year: d.Year, Month: d.Month, Day: GetDaysInMonth(d.Year, d.Month)}
+}
+
+// EndOfQuarter returns the last day of d's quarter: 31 Khordad, 31 Shahrivar,
+// 30 Azar, or the last day of Esfand
+func (d JalaliDate) EndOfQuarter() JalaliDate {
+	last := d.TruncateToQuarter().Month + monthsInQuarter - 1
+	return JalaliDate{Year: d.Year, Month: last, Day: GetDaysInMonth(d.Year, last)}
+}
+
+// EndOfYear returns the last day of d's year, 30 Esfand in leap years
+func (d JalaliDate) EndOfYear() JalaliDate {
+	return LastDayOfYear(d.Year)
+}
+
+// Sub returns the calendar difference d - other as whole years, months and days.
+// Days are borrowed from the month before d, so its actual length (including a
+// 30 day Esfand in leap years) is used. When d is before other all parts are negative.
+func (d JalaliDate) Sub(other JalaliDate) (years, months, days int) {
+	if d.before(other) {
+		years, months, days = other.Sub(d)
+		return -years, -months, -days
+	}
+
+	years = d.Year - other.Year
+	months = d.Month - other.Month
+	days = d.Day - other.Day
+
+	if days < 0 {
+		prevYear, prevMonth := d.Year, d.Month-1
+		if prevMonth < 1 {
+			prevMonth = esfandMonth
+			prevYear--
+		}
+		days += GetDaysInMonth(prevYear, prevMonth)
+		months--
+	}
+	if months < 0 {
+		months += esfandMonth
+		years--
+	}
+	return years, months, days
+}
+
+// DaysBetween returns the number of days from one date to another, negative
+// when to is before from
+func DaysBetween(from, to JalaliDate) int {
+	return JalaliToJDN(to) - JalaliToJDN(from)
+}
+
+// MonthsBetween returns the number of months from one date to another, negative
+// when to is before from. Whole months are counted as by Sub; the days left over
+// are divided by the length of the month in which they start, so 15 days starting
+// in a 30 day month add half a month.
+func MonthsBetween(from, to JalaliDate) float64 {
+	if to.before(from) {
+		return -MonthsBetween(to, from)
+	}
+
+	years, months, days := to.Sub(from)
+	whole := years*esfandMonth + months
+
+	// The leftover days start whole months after from
+	index := from.Year*esfandMonth + from.Month - 1 + whole
+	year, month := index/esfandMonth, index%esfandMonth+1
+	return float64(whole) + float64(days)/float64(GetDaysInMonth(year, month))
+}
+
+// before reports whether d is earlier than other
+func (d JalaliDate) before(other JalaliDate) bool {
+	if d.Year != other.Year {
+		return d.Year < other.Year
+	}
+	if d.Month != other.Month {
+		return d.Month < other.Month
+	}
+	return d.Day < other.Day
+}
+
+// ParseJalali parses a Jalali date written as "1403-05-12" or "1403/05/12"
+// and checks that it exists
+func ParseJalali(s string) (JalaliDate, error) {
+	year, month, day, err := splitDate(s)
+	if err != nil {
+		return JalaliDate{}, err
+	}
+
+	days, err := DaysInMonth(year, month)
+	if err != nil {
+		return JalaliDate{}, err
+	}
+	if day < 1 || day > days {
+		return JalaliDate{}, fmt.Errorf("day %d: %w", day, ErrOutOfRange)
+	}
+	return JalaliDate{Year: year, Month: month, Day: day}, nil
+}
+
+// splitDate splits a "Y-M-D" or "Y/M/D" string into its numeric parts
+func splitDate(s string) (year, month, day int, err error) {
+	parts := strings.FieldsFunc(strings.TrimSpace(s), func(r rune) bool { return r == '-' || r == '/' })
+	if len(parts) != 3 {
+		return 0, 0, 0, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", s)
+	}
+
+	nums := make([]int, len(parts))
+	for i, part := range parts {
+		if nums[i], err = strconv.Atoi(part); err != nil {
+			return 0, 0, 0, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", s)
+		}
+	}
+	return nums[0], nums[1], nums[2], nil
+}
+
+// checkYear verifies that year is within the supported range
+func checkYear(year int) error {
+	if year < MinYear || year > MaxYear {
+		return fmt.Errorf("year %d: %w", year, ErrOutOfRange)
+	}
+	return nil
+}
+
+// checkMonth verifies that month is a valid Jalali month number
+func checkMonth(month int) error {
+	if month < 1 || month > esfandMonth {
+		return fmt.Errorf("month %d: %w", month, ErrOutOfRange)
+	}
+	return nil
+}
+
 // isGregorianLeapYear checks if a Gregorian year is a leap year
 func isGregorianLeapYear(year int) bool {
 	return (year%4 == 0 && year%100 != 0) || year%400 == 0
 }
 
-// jalCal calculates Jalali calendar parameters for a given Jalali year
+// jalCal calculates Jalali calendar parameters for a given Jalali year. It is only
+// defined up to the last entry of breaks, which is why MaxYear stops one year short.
 func jalCal(jy int) jalCalResult {
 	bl := len(breaks)
 	gy := jy + gregorianOffset
@@ -93,124 +278,119 @@ func jalCal(jy int) jalCalResult {
 	return jalCalResult{leap: leap, gy: gy, march: march}
 }
 
-// calculateGregorianDayNumber calculates the Julian Day Number for a Gregorian date
-func calculateGregorianDayNumber(gy, gm, gd int) int {
-	gy2 := gy - 1600
-	gm2 := gm - 1
-	gd2 := gd - 1
-
-	gDayNo := 365*gy2 + div(gy2+3, 4) - div(gy2+99, 100) + div(gy2+399, 400)
-	gDayNo += gregorianMonthOffsets[gm2] + gd2
-
-	if gm > 2 && isGregorianLeapYear(gy) {
-		gDayNo++
-	}
-
-	return gDayNo
+// gregorianToJDN returns the Julian Day Number of a Gregorian date
+func gregorianToJDN(gy, gm, gd int) int {
+	d := div((gy+div(gm-8, 6)+100100)*1461, 4) + div(153*((gm+9)%12)+2, 5) + gd - 34840408
+	return d - div(div(gy+100100+div(gm-8, 6), 100)*3, 4) + 752
 }
 
-// GregorianToJalali converts Gregorian date to Jalali date
-// This is an accurate port from jalaali-js and shams Rust project
-func GregorianToJalali(gy, gm, gd int) JalaliDate {
-	// Calculate the Julian Day Number for the Gregorian date
-	gDayNo := calculateGregorianDayNumber(gy, gm, gd)
+// jdnToGregorian returns the Gregorian date of a Julian Day Number
+func jdnToGregorian(jdn int) (int, int, int) {
+	j := 4*jdn + 139361631
+	j = j + div(div(4*jdn+183187720, cycle400Years)*3, 4)*4 - 3908
+	i := div(j%cycle4Years, 4)*5 + 308
+	gd := div(i%153, 5) + 1
+	gm := div(i, 153)%12 + 1
+	gy := div(j, cycle4Years) - 100100 + div(8-gm, 6)
+	return gy, gm, gd
+}
 
-	// Convert to Jalali
-	jDayNo := gDayNo - julianDayOffset
-	jNp := div(jDayNo, cycle33Years) // 12053 = 33 years
-	jDayNo = jDayNo % cycle33Years
-	jy := 979 + 33*jNp + 4*div(jDayNo, cycle4Years)
-	jDayNo = jDayNo % cycle4Years
+// JalaliToJDN returns the Julian Day Number of a Jalali date
+func JalaliToJDN(d JalaliDate) int {
+	jCal := jalCal(d.Year)
+	return gregorianToJDN(jCal.gy, 3, jCal.march) + (d.Month-1)*31 - div(d.Month, 7)*(d.Month-7) + d.Day - 1
+}
 
-	if jDayNo >= 366 {
-		jy += div(jDayNo-1, 365)
-		jDayNo = (jDayNo - 1) % 365
-	}
+// JDNToJalali returns the Jalali date of a Julian Day Number
+func JDNToJalali(jdn int) JalaliDate {
+	gy, _, _ := jdnToGregorian(jdn)
+	jy := gy - gregorianOffset
+	jCal := jalCal(jy)
 
-	var jm, jd int
-	if jDayNo < firstHalfDays {
-		jm = 1 + div(jDayNo, 31)
-		jd = 1 + (jDayNo % 31)
+	// Days since 1 Farvardin of jy
+	k := jdn - gregorianToJDN(gy, 3, jCal.march)
+	if k >= 0 {
+		if k < firstHalfDays {
+			return JalaliDate{Year: jy, Month: 1 + div(k, 31), Day: k%31 + 1}
+		}
+		k -= firstHalfDays
 	} else {
-		jm = 7 + div(jDayNo-firstHalfDays, 30)
-		jd = 1 + ((jDayNo - firstHalfDays) % 30)
+		// The date falls in the last months of the previous Jalali year. Counting
+		// from that year's own Nowruz keeps this the exact inverse of JalaliToJDN.
+		jy--
+		prev := jalCal(jy)
+		k = jdn - gregorianToJDN(prev.gy, 3, prev.march) - firstHalfDays
 	}
 
-	return JalaliDate{Year: jy, Month: jm, Day: jd}
+	return JalaliDate{Year: jy, Month: 7 + div(k, 30), Day: k%30 + 1}
 }
 
-// JalaliToGregorian converts Jalali date to Gregorian date
-func JalaliToGregorian(jy, jm, jd int) (int, int, int) {
-	gy := 0
-	if jy > 979 {
-		gy = 1600
-		jy -= 979
-	} else {
-		gy = 621
+// gregorianDaysInMonth returns the number of days in a Gregorian month
+func gregorianDaysInMonth(gy, gm int) int {
+	if gm == 2 && isGregorianLeapYear(gy) {
+		return 29
 	}
+	return gregorianMonthDays[gm-1]
+}
 
-	jCal := jalCal(jy)
-
-	// Calculate total days from Jalali epoch
-	days := 365*jy + div(jy, 33)*8 + div((jy%33)+3, 4)
-
-	// Add days for months before the current month
-	for i := 0; i < jm-1; i++ {
-		if i == esfandMonth-1 && IsJalaliLeapYear(jy) {
-			days += 30 // Esfand in leap year has 30 days
-		} else {
-			days += daysInMonth[i]
-		}
+// GregorianMonthDays returns the Jalali date of every day of a Gregorian month, in order
+func GregorianMonthDays(gy, gm int) []JalaliDate {
+	days := make([]JalaliDate, gregorianDaysInMonth(gy, gm))
+	for i := range days {
+		days[i] = GregorianToJalali(gy, gm, i+1)
 	}
+	return days
+}
 
-	days += jd - 1
-	gy += jCal.march + days
-
-	// Convert back to Gregorian
-	gDayNo := gy
-	gy = 400 * div(gDayNo, cycle400Years)
-	gDayNo = gDayNo % cycle400Years
-
-	leap := true
-	if gDayNo >= 36525 {
-		gDayNo--
-		gy += 100 * div(gDayNo, cycle100Years)
-		gDayNo = gDayNo % cycle100Years
-		if gDayNo >= 365 {
-			gDayNo++
-		} else {
-			leap = false
-		}
+// ValidateGregorian returns an error when the given year, month and day do not form a real Gregorian date,
+// or one falling outside the Jalali years MinYear to MaxYear
+func ValidateGregorian(gy, gm, gd int) error {
+	if gm < 1 || gm > 12 {
+		return fmt.Errorf("month %d: %w", gm, ErrOutOfRange)
 	}
 
-	gy += 4 * div(gDayNo, cycle4YearsIn100)
-	gDayNo = gDayNo % cycle4YearsIn100
-
-	if gDayNo >= 366 {
-		leap = false
-		gDayNo--
-		gy += div(gDayNo, 365)
-		gDayNo = gDayNo % 365
+	if gd < 1 || gd > gregorianDaysInMonth(gy, gm) {
+		return fmt.Errorf("day %d of %d-%02d: %w", gd, gy, gm, ErrOutOfRange)
 	}
 
-	// Find month and day
-	gm, gd := 0, 0
-	var monthOffsets []int
-	if leap {
-		monthOffsets = gregorianMonthOffsetsLeap[:]
-	} else {
-		monthOffsets = gregorianMonthOffsets[:]
+	jdn := gregorianToJDN(gy, gm, gd)
+	if jdn < JalaliToJDN(FirstDayOfYear(MinYear)) || jdn > JalaliToJDN(LastDayOfYear(MaxYear)) {
+		return fmt.Errorf("%04d-%02d-%02d is outside Jalali years %d to %d: %w", gy, gm, gd, MinYear, MaxYear, ErrOutOfRange)
 	}
+	return nil
+}
 
-	for i := 11; i >= 0; i-- {
-		if gDayNo >= monthOffsets[i] {
-			gm = i + 1
-			gd = gDayNo - monthOffsets[i] + 1
-			break
-		}
+// ParseGregorian parses a Gregorian date written as YYYY-MM-DD or YYYY/MM/DD and
+// returns it at midnight UTC. Dates that do not exist, such as 2023-02-29, are rejected.
+func ParseGregorian(s string) (time.Time, error) {
+	gy, gm, gd, err := splitDate(s)
+	if err != nil {
+		return time.Time{}, err
 	}
+	if err := ValidateGregorian(gy, gm, gd); err != nil {
+		return time.Time{}, err
+	}
+	return time.Date(gy, time.Month(gm), gd, 0, 0, 0, 0, time.UTC), nil
+}
 
-	return gy, gm, gd
+// GregorianToJalali converts Gregorian date to Jalali date
+// Like jalaali-js, it goes through the Julian Day Number, so it is the exact
+// inverse of JalaliToGregorian and follows the same leap years as IsJalaliLeapYear.
+// The input is expected to be a valid date; see ValidateGregorian.
+func GregorianToJalali(gy, gm, gd int) JalaliDate {
+	return JDNToJalali(gregorianToJDN(gy, gm, gd))
+}
+
+// GregorianToJalaliWithWeekday converts a Gregorian date like GregorianToJalali and also
+// returns its weekday, counted from Saturday as in GetDayOfWeek. The weekday is taken
+// from the Gregorian date itself instead of converting the result back.
+func GregorianToJalaliWithWeekday(gy, gm, gd int) (JalaliDate, int) {
+	return GregorianToJalali(gy, gm, gd), (gregorianToJDN(gy, gm, gd) + 2) % 7
+}
+
+// JalaliToGregorian converts Jalali date to Gregorian date
+func JalaliToGregorian(jy, jm, jd int) (int, int, int) {
+	return jdnToGregorian(JalaliToJDN(JalaliDate{Year: jy, Month: jm, Day: jd}))
 }
 
 // IsJalaliLeapYear determines if a Jalali year is a leap year using the accurate algorithm
@@ -218,7 +398,44 @@ func IsJalaliLeapYear(jy int) bool {
 	return jalCal(jy).leap == leapYearIndicator
 }
 
-// GetDaysInMonth returns the number of days in a given Jalali month
+// YearLength returns the number of days (365 or 366) in a Jalali year.
+// It is derived from the days between consecutive Nowruz dates rather than from
+// IsJalaliLeapYear, so the two can be checked against each other: the days of
+// the twelve months returned by GetDaysInMonth always add up to YearLength.
+func YearLength(year int) int {
+	return JalaliToJDN(FirstDayOfYear(year+1)) - JalaliToJDN(FirstDayOfYear(year))
+}
+
+// DaysInMonth returns the number of days in a Jalali month, or an error wrapping
+// ErrOutOfRange when year is not supported or month is not between 1 and 12
+func DaysInMonth(year, month int) (int, error) {
+	if err := checkYear(year); err != nil {
+		return 0, err
+	}
+	if err := checkMonth(month); err != nil {
+		return 0, err
+	}
+	return GetDaysInMonth(year, month), nil
+}
+
+// FirstDayOfYear returns 1 Farvardin, Nowruz, of a Jalali year
+func FirstDayOfYear(year int) JalaliDate {
+	return JalaliDate{Year: year, Month: 1, Day: 1}
+}
+
+// LastDayOfYear returns the last day of a Jalali year: 30 Esfand in leap years and 29 Esfand otherwise
+func LastDayOfYear(year int) JalaliDate {
+	return JalaliDate{Year: year, Month: esfandMonth, Day: GetDaysInMonth(year, esfandMonth)}
+}
+
+// IsFirstDayOfYear reports whether d is Nowruz, the first day of its year
+func (d JalaliDate) IsFirstDayOfYear() bool {
+	return d == FirstDayOfYear(d.Year)
+}
+
+// GetDaysInMonth returns the number of days in a given Jalali month.
+// month must be between 1 and 12; it panics otherwise. Use DaysInMonth for
+// months that have not been validated.
 func GetDaysInMonth(year, month int) int {
 	if month == esfandMonth && IsJalaliLeapYear(year) {
 		return 30 // Esfand in leap year has 30 days
@@ -226,20 +443,92 @@ func GetDaysInMonth(year, month int) int {
 	return daysInMonth[month-1]
 }
 
-// GetDayOfWeek returns the day of week (0=Sunday, 1=Monday, etc.)
+// GetDayOfWeek returns the day of week, counted from Saturday (0=Shanbe, 1=Yekshanbe, ..., 6=Jome)
 func GetDayOfWeek(year, month, day int) int {
-	gYear, gMonth, gDay := JalaliToGregorian(year, month, day)
-	t := time.Date(gYear, time.Month(gMonth), gDay, 0, 0, 0, 0, time.UTC)
-	return (int(t.Weekday()) + 6) % 7
+	// Julian Day Numbers divisible by 7 fall on a Monday, two days after Shanbe
+	return (JalaliToJDN(JalaliDate{Year: year, Month: month, Day: day}) + 2) % 7
 }
 
 // GetMonthCalendar returns a 2D array representing the calendar for a month
 func GetMonthCalendar(year, month int) [][]int {
-	daysInMonth := GetDaysInMonth(year, month)
-	firstDayOfWeek := GetDayOfWeek(year, month, 1)
+	return buildMonthGrid(GetDaysInMonth(year, month), GetDayOfWeek(year, month, 1))
+}
+
+// CalendarCell is a cell of a month grid that also shows the adjacent months
+type CalendarCell struct {
+	Date JalaliDate
+	// CurrentMonth is false for the days of the previous and next months
+	CurrentMonth bool
+}
+
+// GetMonthCalendarWithAdjacent returns the weeks of GetMonthCalendar with the empty
+// cells filled by the last days of the previous month and the first days of the next
+func GetMonthCalendarWithAdjacent(year, month int) [][]CalendarCell {
+	return fillAdjacent(year, month, GetMonthCalendar(year, month))
+}
+
+// fillAdjacent turns a month grid into cells, giving the empty cells the dates
+// of the adjacent months they stand for
+func fillAdjacent(year, month int, grid [][]int) [][]CalendarCell {
+	leading := 0
+	for leading < len(grid[0]) && grid[0][leading] == 0 {
+		leading++
+	}
+	first := JalaliToJDN(JalaliDate{Year: year, Month: month, Day: 1}) - leading
+
+	cells := make([][]CalendarCell, len(grid))
+	for week, days := range grid {
+		cells[week] = make([]CalendarCell, len(days))
+		for column, day := range days {
+			cells[week][column] = CalendarCell{
+				Date:         JDNToJalali(first + week*daysInWeek + column),
+				CurrentMonth: day != 0,
+			}
+		}
+	}
+	return cells
+}
+
+// WeeksInMonth returns the number of week rows a month spans in the calendar grid
+func WeeksInMonth(year, month int) int {
+	return weeksInGrid(GetDaysInMonth(year, month), GetDayOfWeek(year, month, 1))
+}
+
+// WeekOfMonth returns the 1-based week row a date falls on in its month's calendar grid,
+// for a grid whose rows begin on weekStart (0=Shanbe ... 6=Jome)
+func WeekOfMonth(d JalaliDate, weekStart int) int {
+	offset := (GetDayOfWeek(d.Year, d.Month, 1) - weekStart + daysInWeek) % daysInWeek
+	return (d.Day+offset-1)/daysInWeek + 1
+}
+
+// NthWeekdayOfMonth returns the n-th (1-based) occurrence of a weekday in a month,
+// e.g. the second Panjshanbe. It fails when the month has fewer occurrences.
+func NthWeekdayOfMonth(year, month, weekday, n int) (JalaliDate, error) {
+	if err := checkMonth(month); err != nil {
+		return JalaliDate{}, err
+	}
+	if weekday < 0 || weekday > 6 {
+		return JalaliDate{}, fmt.Errorf("weekday %d: %w", weekday, ErrOutOfRange)
+	}
+	if n < 1 {
+		return JalaliDate{}, fmt.Errorf("occurrence %d: %w", n, ErrOutOfRange)
+	}
+
+	day := 1 + (weekday-GetDayOfWeek(year, month, 1)+7)%7 + (n-1)*7
+	if day > GetDaysInMonth(year, month) {
+		return JalaliDate{}, fmt.Errorf("month %d of %d has no occurrence %d of weekday %d: %w", month, year, n, weekday, ErrOutOfRange)
+	}
+	return JalaliDate{Year: year, Month: month, Day: day}, nil
+}
+
+// weeksInGrid returns the number of weeks needed for daysInMonth days starting at the firstDayOfWeek column
+func weeksInGrid(daysInMonth, firstDayOfWeek int) int {
+	return (daysInMonth + firstDayOfWeek + 6) / 7
+}
 
-	// Calculate number of weeks needed
-	weeks := (daysInMonth + firstDayOfWeek + 6) / 7
+// buildMonthGrid lays out daysInMonth days in weeks, starting at the firstDayOfWeek column
+func buildMonthGrid(daysInMonth, firstDayOfWeek int) [][]int {
+	weeks := weeksInGrid(daysInMonth, firstDayOfWeek)
 
 	calendar := make([][]int, weeks)
 	for i := range calendar {
@@ -0,0 +1,581 @@
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestJDNReferenceDates(t *testing.T) {
+	tests := []struct {
+		name string
+		date JalaliDate
+		jdn  int
+	}{
+		// J2000.0, 1 January 2000 at noon, is Julian Day 2451545
+		{"J2000", JalaliDate{1378, 10, 11}, 2451545},
+		// The epoch of the Persian calendar, 22 March 622 in the proleptic Gregorian calendar
+		{"epoch", JalaliDate{1, 1, 1}, 1948321},
+		// The Unix epoch, 1 January 1970
+		{"unix epoch", JalaliDate{1348, 10, 11}, 2440588},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := JalaliToJDN(tt.date); got != tt.jdn {
+				t.Errorf("JalaliToJDN(%v) = %d, want %d", tt.date, got, tt.jdn)
+			}
+			if got := JDNToJalali(tt.jdn); got != tt.date {
+				t.Errorf("JDNToJalali(%d) = %v, want %v", tt.jdn, got, tt.date)
+			}
+		})
+	}
+}
+
+func TestJDNRoundTrip(t *testing.T) {
+	first := JalaliToJDN(FirstDayOfYear(1300))
+	last := JalaliToJDN(LastDayOfYear(1500))
+	prev := JDNToJalali(first - 1)
+	for jdn := first; jdn <= last; jdn++ {
+		d := JDNToJalali(jdn)
+		if got := JalaliToJDN(d); got != jdn {
+			t.Fatalf("JalaliToJDN(JDNToJalali(%d)) = %d (via %v)", jdn, got, d)
+		}
+		if want := nextDay(prev); d != want {
+			t.Fatalf("JDNToJalali(%d) = %v, want %v, the day after %v", jdn, d, want, prev)
+		}
+		prev = d
+	}
+}
+
+// nextDay returns the day after d, counted from the month lengths alone
+func nextDay(d JalaliDate) JalaliDate {
+	switch {
+	case d.Day < GetDaysInMonth(d.Year, d.Month):
+		return JalaliDate{d.Year, d.Month, d.Day + 1}
+	case d.Month < 12:
+		return JalaliDate{d.Year, d.Month + 1, 1}
+	default:
+		return JalaliDate{d.Year + 1, 1, 1}
+	}
+}
+
+func TestSub(t *testing.T) {
+	tests := []struct {
+		name                string
+		d, other            JalaliDate
+		years, months, days int
+	}{
+		{"same day", JalaliDate{1403, 5, 12}, JalaliDate{1403, 5, 12}, 0, 0, 0},
+		{"1 Farvardin minus 30 Esfand", JalaliDate{1404, 1, 1}, JalaliDate{1403, 12, 30}, 0, 0, 1},
+		{"1 Farvardin minus 29 Esfand", JalaliDate{1403, 1, 1}, JalaliDate{1402, 12, 29}, 0, 0, 1},
+		{"borrow a 30 day Esfand", JalaliDate{1404, 1, 10}, JalaliDate{1403, 12, 15}, 0, 0, 25},
+		{"borrow a 29 day Esfand", JalaliDate{1403, 1, 10}, JalaliDate{1402, 12, 15}, 0, 0, 24},
+		{"borrow a 31 day month", JalaliDate{1403, 6, 5}, JalaliDate{1403, 4, 20}, 0, 1, 16},
+		{"across leap years", JalaliDate{1408, 12, 30}, JalaliDate{1399, 12, 30}, 9, 0, 0},
+		{"years months and days", JalaliDate{1403, 5, 12}, JalaliDate{1357, 11, 22}, 45, 5, 21},
+		{"before other", JalaliDate{1403, 12, 30}, JalaliDate{1404, 1, 1}, 0, 0, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			years, months, days := tt.d.Sub(tt.other)
+			if years != tt.years || months != tt.months || days != tt.days {
+				t.Errorf("%v.Sub(%v) = %d, %d, %d, want %d, %d, %d", tt.d, tt.other, years, months, days, tt.years, tt.months, tt.days)
+			}
+		})
+	}
+}
+
+func TestYearLengthMatchesMonths(t *testing.T) {
+	for year := MinYear; year <= MaxYear; year++ {
+		total := 0
+		for month := 1; month <= 12; month++ {
+			total += GetDaysInMonth(year, month)
+		}
+
+		length := YearLength(year)
+		if total != length {
+			t.Fatalf("year %d: months add up to %d days, YearLength is %d", year, total, length)
+		}
+		if leap := IsJalaliLeapYear(year); leap != (length == 366) || length < 365 || length > 366 {
+			t.Fatalf("year %d: YearLength is %d but IsJalaliLeapYear is %v", year, length, leap)
+		}
+	}
+}
+
+func TestGetDayOfWeek(t *testing.T) {
+	tests := []struct {
+		date    JalaliDate
+		weekday int
+	}{
+		{JalaliDate{1403, 1, 1}, 4},   // Wednesday 20 March 2024, Chaharshanbe
+		{JalaliDate{1403, 5, 1}, 2},   // Monday 22 July 2024, Doshanbe
+		{JalaliDate{1403, 12, 30}, 5}, // Thursday 20 March 2025, Panjshanbe
+		{JalaliDate{1404, 1, 1}, 6},   // Friday 21 March 2025, Jome
+		{JalaliDate{1378, 10, 11}, 0}, // Saturday 1 January 2000, Shanbe
+		{JalaliDate{1357, 11, 22}, 1}, // Sunday 11 February 1979, Yekshanbe
+		{JalaliDate{1348, 10, 11}, 5}, // Thursday 1 January 1970, Panjshanbe
+	}
+	for _, tt := range tests {
+		if got := GetDayOfWeek(tt.date.Year, tt.date.Month, tt.date.Day); got != tt.weekday {
+			t.Errorf("GetDayOfWeek(%v) = %d, want %d", tt.date, got, tt.weekday)
+		}
+	}
+}
+
+// JalaliToGregorian used to return dates around year 428 for the current era,
+// with a weekday offset that happened to cancel the error out. Walk the days from
+// a known Nowruz alongside the time package and compare both the date and weekday.
+func TestJalaliToGregorianFollowsTimePackage(t *testing.T) {
+	d := JalaliDate{1300, 1, 1}
+	g := time.Date(1921, time.March, 21, 0, 0, 0, 0, time.UTC)
+	for d.Year < 1500 {
+		gy, gm, gd := JalaliToGregorian(d.Year, d.Month, d.Day)
+		if gy != g.Year() || gm != int(g.Month()) || gd != g.Day() {
+			t.Fatalf("JalaliToGregorian(%v) = %04d-%02d-%02d, want %s", d, gy, gm, gd, g.Format(time.DateOnly))
+		}
+		if got, want := GetDayOfWeek(d.Year, d.Month, d.Day), (int(g.Weekday())+1)%7; got != want {
+			t.Fatalf("GetDayOfWeek(%v) = %d, want %d for %s", d, got, want, g.Format("Monday"))
+		}
+		if got := GregorianToJalali(g.Year(), int(g.Month()), g.Day()); got != d {
+			t.Fatalf("GregorianToJalali(%s) = %v, want %v", g.Format(time.DateOnly), got, d)
+		}
+		d, g = nextDay(d), g.AddDate(0, 0, 1)
+	}
+}
+
+func TestGregorianToJalaliWithWeekday(t *testing.T) {
+	check := func(g time.Time) {
+		t.Helper()
+		gy, gm, gd := g.Year(), int(g.Month()), g.Day()
+		date, weekday := GregorianToJalaliWithWeekday(gy, gm, gd)
+		if want := GregorianToJalali(gy, gm, gd); date != want {
+			t.Fatalf("GregorianToJalaliWithWeekday(%s) date = %v, want %v", g.Format(time.DateOnly), date, want)
+		}
+		if want := GetDayOfWeek(date.Year, date.Month, date.Day); weekday != want {
+			t.Fatalf("GregorianToJalaliWithWeekday(%s) weekday = %d, GetDayOfWeek(%v) = %d", g.Format(time.DateOnly), weekday, date, want)
+		}
+		if want := (int(g.Weekday()) + 1) % 7; weekday != want {
+			t.Fatalf("GregorianToJalaliWithWeekday(%s) weekday = %d, want %d for %s", g.Format(time.DateOnly), weekday, want, g.Format("Monday"))
+		}
+	}
+
+	// Every day of a few decades around the present, through leap years on both calendars
+	for g := time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC); g.Year() < 2050; g = g.AddDate(0, 0, 1) {
+		check(g)
+	}
+	// and the first and last days of the supported Jalali years
+	for _, d := range []JalaliDate{FirstDayOfYear(MinYear), LastDayOfYear(MaxYear)} {
+		gy, gm, gd := JalaliToGregorian(d.Year, d.Month, d.Day)
+		check(time.Date(gy, time.Month(gm), gd, 0, 0, 0, 0, time.UTC))
+	}
+}
+
+func TestDaysInMonth(t *testing.T) {
+	tests := []struct {
+		year, month int
+		want        int
+		err         bool
+	}{
+		{1403, 1, 31, false},
+		{1403, 6, 31, false},
+		{1403, 7, 30, false},
+		{1403, 12, 30, false},
+		{1402, 12, 29, false},
+		{1403, 0, 0, true},
+		{1403, 13, 0, true},
+		{1403, -1, 0, true},
+		{MinYear - 1, 1, 0, true},
+		{MaxYear + 1, 1, 0, true},
+	}
+	for _, tt := range tests {
+		got, err := DaysInMonth(tt.year, tt.month)
+		if tt.err {
+			if !errors.Is(err, ErrOutOfRange) {
+				t.Errorf("DaysInMonth(%d, %d) error = %v, want ErrOutOfRange", tt.year, tt.month, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("DaysInMonth(%d, %d) = %d, %v, want %d", tt.year, tt.month, got, err, tt.want)
+		}
+	}
+}
+
+func TestSupportedYearBounds(t *testing.T) {
+	for _, year := range []int{MinYear, MaxYear} {
+		t.Run(fmt.Sprint(year), func(t *testing.T) {
+			gy, gm, gd := JalaliToGregorian(year, 1, 1)
+			if gy != year+621 || gm != 3 || gd < 19 || gd > 22 {
+				t.Errorf("Nowruz of %d falls on %04d-%02d-%02d, want 19 to 22 March %d", year, gy, gm, gd, year+621)
+			}
+
+			for d := FirstDayOfYear(year); d.Year == year; d = nextDay(d) {
+				gy, gm, gd := JalaliToGregorian(d.Year, d.Month, d.Day)
+				if err := ValidateGregorian(gy, gm, gd); err != nil {
+					t.Fatalf("JalaliToGregorian(%v) = %04d-%02d-%02d: %v", d, gy, gm, gd, err)
+				}
+				if got := GregorianToJalali(gy, gm, gd); got != d {
+					t.Fatalf("GregorianToJalali(JalaliToGregorian(%v)) = %v", d, got)
+				}
+			}
+		})
+	}
+}
+
+func TestParseJalali(t *testing.T) {
+	tests := []struct {
+		in         string
+		want       JalaliDate
+		outOfRange bool
+		wantErr    bool
+	}{
+		{in: "1403-05-12", want: JalaliDate{Year: 1403, Month: 5, Day: 12}},
+		{in: "1403/05/12", want: JalaliDate{Year: 1403, Month: 5, Day: 12}},
+		{in: " 1403-5-2 ", want: JalaliDate{Year: 1403, Month: 5, Day: 2}},
+		{in: "1403-12-30", want: JalaliDate{Year: 1403, Month: 12, Day: 30}},
+		{in: "1-01-01", want: JalaliDate{Year: 1, Month: 1, Day: 1}},
+		{in: "1402-12-30", outOfRange: true},
+		{in: "1403-07-31", outOfRange: true},
+		{in: "1403-13-01", outOfRange: true},
+		{in: "1403-00-10", outOfRange: true},
+		{in: "1403-05-00", outOfRange: true},
+		{in: "0-01-01", outOfRange: true},
+		{in: "3178-01-01", outOfRange: true},
+		{in: "1403-05", wantErr: true},
+		{in: "1403-05-12-01", wantErr: true},
+		{in: "1403-05-1x", wantErr: true},
+		{in: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseJalali(tt.in)
+		switch {
+		case tt.outOfRange:
+			if !errors.Is(err, ErrOutOfRange) {
+				t.Errorf("ParseJalali(%q) error %v, want ErrOutOfRange", tt.in, err)
+			}
+		case tt.wantErr:
+			if err == nil || errors.Is(err, ErrOutOfRange) {
+				t.Errorf("ParseJalali(%q) error %v, want a format error", tt.in, err)
+			}
+		case err != nil || got != tt.want:
+			t.Errorf("ParseJalali(%q) = %v, %v, want %v", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestParseGregorian(t *testing.T) {
+	tests := []struct {
+		in         string
+		want       time.Time
+		outOfRange bool
+		wantErr    bool
+	}{
+		{in: "2024-08-02", want: time.Date(2024, 8, 2, 0, 0, 0, 0, time.UTC)},
+		{in: "2024/02/29", want: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{in: "2000-02-29", want: time.Date(2000, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{in: " 2024-8-2 ", want: time.Date(2024, 8, 2, 0, 0, 0, 0, time.UTC)},
+		{in: "2023-02-29", outOfRange: true},
+		{in: "2100-02-29", outOfRange: true},
+		{in: "2024-04-31", outOfRange: true},
+		{in: "2024-13-01", outOfRange: true},
+		{in: "2024-00-01", outOfRange: true},
+		{in: "5000-01-01", outOfRange: true},
+		{in: "2024-08", wantErr: true},
+		{in: "2024-08-02x", wantErr: true},
+		{in: "August 2, 2024", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseGregorian(tt.in)
+		switch {
+		case tt.outOfRange:
+			if !errors.Is(err, ErrOutOfRange) {
+				t.Errorf("ParseGregorian(%q) error %v, want ErrOutOfRange", tt.in, err)
+			}
+		case tt.wantErr:
+			if err == nil || errors.Is(err, ErrOutOfRange) {
+				t.Errorf("ParseGregorian(%q) error %v, want a format error", tt.in, err)
+			}
+		case err != nil || !got.Equal(tt.want):
+			t.Errorf("ParseGregorian(%q) = %v, %v, want %v", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestWeekOfMonth(t *testing.T) {
+	tests := []struct {
+		date JalaliDate
+		want int
+	}{
+		// Mordad 1403 starts on Doshanbe and fills five week rows
+		{JalaliDate{Year: 1403, Month: 5, Day: 1}, 1},
+		{JalaliDate{Year: 1403, Month: 5, Day: 5}, 1},
+		{JalaliDate{Year: 1403, Month: 5, Day: 6}, 2},
+		{JalaliDate{Year: 1403, Month: 5, Day: 12}, 2},
+		{JalaliDate{Year: 1403, Month: 5, Day: 31}, 5},
+		// Farvardin 1404 starts on Jome and needs a sixth row
+		{JalaliDate{Year: 1404, Month: 1, Day: 1}, 1},
+		{JalaliDate{Year: 1404, Month: 1, Day: 2}, 2},
+		{JalaliDate{Year: 1404, Month: 1, Day: 30}, 6},
+		{JalaliDate{Year: 1404, Month: 1, Day: 31}, 6},
+	}
+	for _, tt := range tests {
+		if got := WeekOfMonth(tt.date, 0); got != tt.want {
+			t.Errorf("WeekOfMonth(%v) = %d, want %d", tt.date, got, tt.want)
+		}
+	}
+
+	for _, tt := range []struct{ year, month, want int }{{1403, 5, 5}, {1404, 1, 6}, {1404, 12, 5}} {
+		if got := WeeksInMonth(tt.year, tt.month); got != tt.want {
+			t.Errorf("WeeksInMonth(%d, %d) = %d, want %d", tt.year, tt.month, got, tt.want)
+		}
+		last := JalaliDate{Year: tt.year, Month: tt.month, Day: GetDaysInMonth(tt.year, tt.month)}
+		if got := WeekOfMonth(last, 0); got != tt.want {
+			t.Errorf("WeekOfMonth(%v) = %d, want the last row %d", last, got, tt.want)
+		}
+	}
+}
+
+func TestWeekOfMonthWeekStart(t *testing.T) {
+	tests := []struct {
+		date      JalaliDate
+		weekStart int
+		want      int
+	}{
+		// Mordad 1403 starts on Doshanbe, so a Doshanbe start leaves no leading blanks
+		{JalaliDate{Year: 1403, Month: 5, Day: 7}, 2, 1},
+		{JalaliDate{Year: 1403, Month: 5, Day: 8}, 2, 2},
+		{JalaliDate{Year: 1403, Month: 5, Day: 31}, 2, 5},
+		// and a Jome start begins a new row on 5 Mordad
+		{JalaliDate{Year: 1403, Month: 5, Day: 4}, 6, 1},
+		{JalaliDate{Year: 1403, Month: 5, Day: 5}, 6, 2},
+		{JalaliDate{Year: 1403, Month: 5, Day: 25}, 6, 4},
+		{JalaliDate{Year: 1403, Month: 5, Day: 26}, 6, 5},
+		// Farvardin 1404 starts on Jome: five rows from Jome, the first one short from Yekshanbe
+		{JalaliDate{Year: 1404, Month: 1, Day: 31}, 6, 5},
+		{JalaliDate{Year: 1404, Month: 1, Day: 2}, 1, 1},
+		{JalaliDate{Year: 1404, Month: 1, Day: 3}, 1, 2},
+	}
+	for _, tt := range tests {
+		if got := WeekOfMonth(tt.date, tt.weekStart); got != tt.want {
+			t.Errorf("WeekOfMonth(%v, %d) = %d, want %d", tt.date, tt.weekStart, got, tt.want)
+		}
+	}
+
+	// The row advances exactly where StartOfWeek moves to a new week
+	for weekStart := 0; weekStart < daysInWeek; weekStart++ {
+		for month := 1; month <= 12; month++ {
+			row := 1
+			for day := 1; day <= GetDaysInMonth(1403, month); day++ {
+				d := JalaliDate{Year: 1403, Month: month, Day: day}
+				if day > 1 && d.StartOfWeek(weekStart) == d {
+					row++
+				}
+				if got := WeekOfMonth(d, weekStart); got != row {
+					t.Errorf("WeekOfMonth(%v, %d) = %d, want %d", d, weekStart, got, row)
+				}
+			}
+		}
+	}
+}
+
+func TestNthWeekdayOfMonth(t *testing.T) {
+	tests := []struct {
+		year, month, weekday, n int
+		want                    JalaliDate
+		wantErr                 bool
+	}{
+		// Mordad 1403 starts on Doshanbe (2)
+		{year: 1403, month: 5, weekday: 2, n: 1, want: JalaliDate{Year: 1403, Month: 5, Day: 1}},
+		{year: 1403, month: 5, weekday: 2, n: 5, want: JalaliDate{Year: 1403, Month: 5, Day: 29}},
+		{year: 1403, month: 5, weekday: 5, n: 1, want: JalaliDate{Year: 1403, Month: 5, Day: 4}},
+		{year: 1403, month: 5, weekday: 5, n: 2, want: JalaliDate{Year: 1403, Month: 5, Day: 11}},
+		{year: 1403, month: 5, weekday: 0, n: 1, want: JalaliDate{Year: 1403, Month: 5, Day: 6}},
+		{year: 1403, month: 5, weekday: 1, n: 4, want: JalaliDate{Year: 1403, Month: 5, Day: 28}},
+		{year: 1403, month: 5, weekday: 5, n: 5, wantErr: true},
+		{year: 1403, month: 13, weekday: 0, n: 1, wantErr: true},
+		{year: 1403, month: 5, weekday: 7, n: 1, wantErr: true},
+		{year: 1403, month: 5, weekday: -1, n: 1, wantErr: true},
+		{year: 1403, month: 5, weekday: 0, n: 0, wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := NthWeekdayOfMonth(tt.year, tt.month, tt.weekday, tt.n)
+		if tt.wantErr {
+			if !errors.Is(err, ErrOutOfRange) {
+				t.Errorf("NthWeekdayOfMonth(%d, %d, %d, %d) = %v, %v, want ErrOutOfRange",
+					tt.year, tt.month, tt.weekday, tt.n, got, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("NthWeekdayOfMonth(%d, %d, %d, %d) = %v, %v, want %v",
+				tt.year, tt.month, tt.weekday, tt.n, got, err, tt.want)
+		}
+		if day := GetDayOfWeek(got.Year, got.Month, got.Day); day != tt.weekday {
+			t.Errorf("NthWeekdayOfMonth returned %v, which falls on weekday %d", got, day)
+		}
+	}
+}
+
+func TestDaysBetween(t *testing.T) {
+	tests := []struct {
+		from, to JalaliDate
+		want     int
+	}{
+		{JalaliDate{Year: 1403, Month: 5, Day: 12}, JalaliDate{Year: 1403, Month: 5, Day: 12}, 0},
+		{JalaliDate{Year: 1403, Month: 1, Day: 1}, JalaliDate{Year: 1403, Month: 5, Day: 12}, 135},
+		{JalaliDate{Year: 1403, Month: 1, Day: 1}, JalaliDate{Year: 1404, Month: 1, Day: 1}, 366},
+		{JalaliDate{Year: 1404, Month: 1, Day: 1}, JalaliDate{Year: 1405, Month: 1, Day: 1}, 365},
+		{JalaliDate{Year: 1403, Month: 12, Day: 30}, JalaliDate{Year: 1404, Month: 1, Day: 1}, 1},
+		{JalaliDate{Year: 1404, Month: 1, Day: 1}, JalaliDate{Year: 1403, Month: 12, Day: 29}, -2},
+	}
+	for _, tt := range tests {
+		if got := DaysBetween(tt.from, tt.to); got != tt.want {
+			t.Errorf("DaysBetween(%v, %v) = %d, want %d", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestMonthsBetween(t *testing.T) {
+	tests := []struct {
+		from, to JalaliDate
+		want     float64
+	}{
+		{JalaliDate{Year: 1403, Month: 5, Day: 12}, JalaliDate{Year: 1403, Month: 5, Day: 12}, 0},
+		// 15 days starting in Mehr, a 30 day month
+		{JalaliDate{Year: 1403, Month: 7, Day: 1}, JalaliDate{Year: 1403, Month: 7, Day: 16}, 0.5},
+		// 15 days starting in Bahman and running into Esfand
+		{JalaliDate{Year: 1403, Month: 11, Day: 20}, JalaliDate{Year: 1403, Month: 12, Day: 5}, 0.5},
+		// the 11 days left after 4 months start in Mordad, a 31 day month
+		{JalaliDate{Year: 1403, Month: 1, Day: 1}, JalaliDate{Year: 1403, Month: 5, Day: 12}, 4 + 11.0/31},
+		{JalaliDate{Year: 1403, Month: 5, Day: 12}, JalaliDate{Year: 1403, Month: 1, Day: 1}, -(4 + 11.0/31)},
+		{JalaliDate{Year: 1403, Month: 1, Day: 1}, JalaliDate{Year: 1404, Month: 1, Day: 1}, 12},
+		{JalaliDate{Year: 1403, Month: 12, Day: 15}, JalaliDate{Year: 1404, Month: 1, Day: 15}, 1},
+		{JalaliDate{Year: 1400, Month: 6, Day: 1}, JalaliDate{Year: 1403, Month: 6, Day: 1}, 36},
+	}
+	for _, tt := range tests {
+		if got := MonthsBetween(tt.from, tt.to); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("MonthsBetween(%v, %v) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestAddWeeks(t *testing.T) {
+	tests := []struct {
+		date JalaliDate
+		n    int
+		want JalaliDate
+	}{
+		{JalaliDate{Year: 1403, Month: 5, Day: 12}, 0, JalaliDate{Year: 1403, Month: 5, Day: 12}},
+		{JalaliDate{Year: 1403, Month: 5, Day: 12}, 1, JalaliDate{Year: 1403, Month: 5, Day: 19}},
+		{JalaliDate{Year: 1403, Month: 5, Day: 12}, -1, JalaliDate{Year: 1403, Month: 5, Day: 5}},
+		{JalaliDate{Year: 1403, Month: 5, Day: 12}, -2, JalaliDate{Year: 1403, Month: 4, Day: 29}},
+		{JalaliDate{Year: 1403, Month: 12, Day: 20}, 3, JalaliDate{Year: 1404, Month: 1, Day: 11}},
+		{JalaliDate{Year: 1404, Month: 1, Day: 3}, -1, JalaliDate{Year: 1403, Month: 12, Day: 26}},
+		{JalaliDate{Year: 1404, Month: 1, Day: 1}, -52, JalaliDate{Year: 1403, Month: 1, Day: 3}},
+	}
+	for _, tt := range tests {
+		if got := tt.date.AddWeeks(tt.n); got != tt.want {
+			t.Errorf("%v.AddWeeks(%d) = %v, want %v", tt.date, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestStartAndEndOfWeek(t *testing.T) {
+	tests := []struct {
+		date       JalaliDate
+		weekStart  int
+		start, end JalaliDate
+	}{
+		// 30 Esfand 1403 is a Panjshanbe, the day before Nowruz
+		{JalaliDate{Year: 1403, Month: 12, Day: 30}, 0, JalaliDate{Year: 1403, Month: 12, Day: 25}, JalaliDate{Year: 1404, Month: 1, Day: 1}},
+		{JalaliDate{Year: 1403, Month: 12, Day: 30}, 1, JalaliDate{Year: 1403, Month: 12, Day: 26}, JalaliDate{Year: 1404, Month: 1, Day: 2}},
+		{JalaliDate{Year: 1403, Month: 12, Day: 30}, 2, JalaliDate{Year: 1403, Month: 12, Day: 27}, JalaliDate{Year: 1404, Month: 1, Day: 3}},
+		{JalaliDate{Year: 1403, Month: 12, Day: 30}, 3, JalaliDate{Year: 1403, Month: 12, Day: 28}, JalaliDate{Year: 1404, Month: 1, Day: 4}},
+		{JalaliDate{Year: 1403, Month: 12, Day: 30}, 4, JalaliDate{Year: 1403, Month: 12, Day: 29}, JalaliDate{Year: 1404, Month: 1, Day: 5}},
+		{JalaliDate{Year: 1403, Month: 12, Day: 30}, 5, JalaliDate{Year: 1403, Month: 12, Day: 30}, JalaliDate{Year: 1404, Month: 1, Day: 6}},
+		{JalaliDate{Year: 1403, Month: 12, Day: 30}, 6, JalaliDate{Year: 1403, Month: 12, Day: 24}, JalaliDate{Year: 1403, Month: 12, Day: 30}},
+		// 29 Esfand 1404, in a common year, is a Jome
+		{JalaliDate{Year: 1404, Month: 12, Day: 29}, 0, JalaliDate{Year: 1404, Month: 12, Day: 23}, JalaliDate{Year: 1404, Month: 12, Day: 29}},
+		{JalaliDate{Year: 1404, Month: 12, Day: 29}, 6, JalaliDate{Year: 1404, Month: 12, Day: 29}, JalaliDate{Year: 1405, Month: 1, Day: 6}},
+		// 1 Mordad 1403 is a Doshanbe; its week starts in Tir
+		{JalaliDate{Year: 1403, Month: 5, Day: 1}, 0, JalaliDate{Year: 1403, Month: 4, Day: 30}, JalaliDate{Year: 1403, Month: 5, Day: 5}},
+		{JalaliDate{Year: 1403, Month: 5, Day: 1}, 2, JalaliDate{Year: 1403, Month: 5, Day: 1}, JalaliDate{Year: 1403, Month: 5, Day: 7}},
+		{JalaliDate{Year: 1403, Month: 5, Day: 1}, 3, JalaliDate{Year: 1403, Month: 4, Day: 26}, JalaliDate{Year: 1403, Month: 5, Day: 1}},
+	}
+	for _, tt := range tests {
+		if got := tt.date.StartOfWeek(tt.weekStart); got != tt.start {
+			t.Errorf("%v.StartOfWeek(%d) = %v, want %v", tt.date, tt.weekStart, got, tt.start)
+		}
+		if got := tt.date.EndOfWeek(tt.weekStart); got != tt.end {
+			t.Errorf("%v.EndOfWeek(%d) = %v, want %v", tt.date, tt.weekStart, got, tt.end)
+		}
+	}
+}
+
+func TestWeekBoundsEveryDay(t *testing.T) {
+	end := FirstDayOfYear(1405)
+	for d := FirstDayOfYear(1403); d != end; d = d.AddDays(1) {
+		for weekStart := 0; weekStart < daysInWeek; weekStart++ {
+			start, last := d.StartOfWeek(weekStart), d.EndOfWeek(weekStart)
+			if day := GetDayOfWeek(start.Year, start.Month, start.Day); day != weekStart {
+				t.Fatalf("%v.StartOfWeek(%d) = %v, a day of weekday %d", d, weekStart, start, day)
+			}
+			if DaysBetween(start, last) != daysInWeek-1 || DaysBetween(start, d) < 0 || DaysBetween(d, last) < 0 {
+				t.Fatalf("week %v..%v for weekStart %d does not hold %v", start, last, weekStart, d)
+			}
+		}
+	}
+}
+
+func TestTruncateAndEnd(t *testing.T) {
+	date := func(y, m, d int) JalaliDate { return JalaliDate{Year: y, Month: m, Day: d} }
+	tests := []struct {
+		date                       JalaliDate
+		month, quarter, year       JalaliDate
+		endMonth, endQuarter, endY JalaliDate
+	}{
+		{date(1403, 1, 1), date(1403, 1, 1), date(1403, 1, 1), date(1403, 1, 1), date(1403, 1, 31), date(1403, 3, 31), date(1403, 12, 30)},
+		{date(1403, 3, 31), date(1403, 3, 1), date(1403, 1, 1), date(1403, 1, 1), date(1403, 3, 31), date(1403, 3, 31), date(1403, 12, 30)},
+		{date(1403, 5, 12), date(1403, 5, 1), date(1403, 4, 1), date(1403, 1, 1), date(1403, 5, 31), date(1403, 6, 31), date(1403, 12, 30)},
+		{date(1403, 7, 1), date(1403, 7, 1), date(1403, 7, 1), date(1403, 1, 1), date(1403, 7, 30), date(1403, 9, 30), date(1403, 12, 30)},
+		{date(1403, 9, 30), date(1403, 9, 1), date(1403, 7, 1), date(1403, 1, 1), date(1403, 9, 30), date(1403, 9, 30), date(1403, 12, 30)},
+		// Esfand has 30 days in the leap year 1403 and 29 in 1404
+		{date(1403, 10, 5), date(1403, 10, 1), date(1403, 10, 1), date(1403, 1, 1), date(1403, 10, 30), date(1403, 12, 30), date(1403, 12, 30)},
+		{date(1403, 12, 30), date(1403, 12, 1), date(1403, 10, 1), date(1403, 1, 1), date(1403, 12, 30), date(1403, 12, 30), date(1403, 12, 30)},
+		{date(1404, 12, 1), date(1404, 12, 1), date(1404, 10, 1), date(1404, 1, 1), date(1404, 12, 29), date(1404, 12, 29), date(1404, 12, 29)},
+		{date(1404, 11, 15), date(1404, 11, 1), date(1404, 10, 1), date(1404, 1, 1), date(1404, 11, 30), date(1404, 12, 29), date(1404, 12, 29)},
+	}
+	for _, tt := range tests {
+		checks := []struct {
+			name      string
+			got, want JalaliDate
+		}{
+			{"TruncateToMonth", tt.date.TruncateToMonth(), tt.month},
+			{"TruncateToQuarter", tt.date.TruncateToQuarter(), tt.quarter},
+			{"TruncateToYear", tt.date.TruncateToYear(), tt.year},
+			{"EndOfMonth", tt.date.EndOfMonth(), tt.endMonth},
+			{"EndOfQuarter", tt.date.EndOfQuarter(), tt.endQuarter},
+			{"EndOfYear", tt.date.EndOfYear(), tt.endY},
+		}
+		for _, c := range checks {
+			if c.got != c.want {
+				t.Errorf("%v.%s() = %v, want %v", tt.date, c.name, c.got, c.want)
+			}
+		}
+	}
+}
+
+func TestQuarterEnds(t *testing.T) {
+	for month := 1; month <= 12; month++ {
+		d := JalaliDate{Year: 1403, Month: month, Day: 1}
+		end := d.EndOfQuarter()
+		if want := (month-1)/3*3 + 3; end.Month != want {
+			t.Errorf("%v.EndOfQuarter() is in month %d, want %d", d, end.Month, want)
+		}
+		if next := end.AddDays(1); next.Month != end.Month%12+1 || next.Day != 1 {
+			t.Errorf("%v.EndOfQuarter() = %v is not the last day of its month", d, end)
+		}
+	}
+}
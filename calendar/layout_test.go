@@ -0,0 +1,173 @@
+package calendar
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// layoutToday is 12 Farvardin 1403, itself a holiday, so the goldens hold today's,
+// holiday and weekend colors
+var layoutToday = JalaliDate{Year: 1403, Month: 1, Day: 12}
+
+// layoutCases render the views the grid layout produces. Their goldens were written
+// by the tablewriter based layout, so they pin the output of its replacement.
+var layoutCases = []struct {
+	name   string
+	render func(opts Options) string
+	opts   Options
+}{
+	{"month_color", month(1403, 1), Options{Today: layoutToday, Holidays: true}},
+	{"month_colorful", month(1403, 1), Options{Today: layoutToday, Colorful: true}},
+	{"month_persian", month(1403, 1), Options{Today: layoutToday, Holidays: true, Locale: LocalePersian}},
+	{"month_persian_plain", month(1403, 12), Options{NoToday: true, Theme: &Theme{}, Locale: LocalePersian}},
+	{"month_fixed_height", month(1403, 12), Options{NoToday: true, Theme: &Theme{}, FixedHeight: true}},
+	{"month_adjacent_days", month(1403, 5), Options{Today: layoutToday, AdjacentDays: true}},
+	{"month_aligned", month(1403, 5), Options{NoToday: true, Theme: &Theme{}, HeaderAlign: AlignLeft, DayAlign: AlignRight}},
+	{"three_months_color", threeMonths(1403, 1), Options{Today: layoutToday, Holidays: true}},
+	{"three_months_fixed_height", threeMonths(1403, 12), Options{NoToday: true, Theme: &Theme{}, FixedHeight: true}},
+	{"year_color", year1403, Options{Today: layoutToday, Holidays: true}},
+	{"year_persian", year1403, Options{Today: layoutToday, Locale: LocalePersian}},
+}
+
+func TestLayout(t *testing.T) {
+	for _, tt := range layoutCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assertGolden(t, "layout_"+tt.name, tt.render(tt.opts))
+		})
+	}
+}
+
+// month renders a single month
+func month(year, m int) func(Options) string {
+	return func(opts Options) string {
+		return RenderMonth(year, m, opts)
+	}
+}
+
+// threeMonths renders the three month view around a month
+func threeMonths(year, m int) func(Options) string {
+	return func(opts Options) string {
+		var buf bytes.Buffer
+		opts.Output = &buf
+		if err := DisplayThreeMonthsTable(year, m, opts); err != nil {
+			panic(err)
+		}
+		return buf.String()
+	}
+}
+
+// year1403 renders the year view of 1403
+func year1403(opts Options) string {
+	return RenderYear(1403, opts)
+}
+
+func TestLayoutColumns(t *testing.T) {
+	workweek := []int{0, 1, 2, 3, 4}
+	tests := []struct {
+		name   string
+		render func(opts Options) string
+		opts   Options
+	}{
+		{"month_columns", month(1403, 5), Options{Today: layoutToday, Columns: workweek}},
+		{"month_columns_fixed_height", month(1403, 5), Options{NoToday: true, Theme: &Theme{}, Columns: []int{5, 6}, FixedHeight: true}},
+		{"three_months_columns", threeMonths(1403, 1), Options{Today: layoutToday, Holidays: true, Columns: workweek}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertGolden(t, "layout_"+tt.name, tt.render(tt.opts))
+		})
+	}
+}
+
+func TestToASCII(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"۱۴۰۳-۰۵-۱۲", "1403-05-12"},
+		{"١٤٠٣", "1403"},
+		{"مرداد ۱۴۰۳", "Mordad 1403"},
+		{"Farvardin – Khordad", "Farvardin - Khordad"},
+		{"یکشنبه", "Yek"},
+		{"★", "?"},
+	}
+	for _, tt := range tests {
+		if got := ToASCII(tt.in); got != tt.want {
+			t.Errorf("ToASCII(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestYearMonthsPerRow(t *testing.T) {
+	for _, perRow := range []int{2, 4} {
+		t.Run(fmt.Sprint(perRow), func(t *testing.T) {
+			opts := plain
+			opts.MonthsPerRow = perRow
+			assertGolden(t, fmt.Sprintf("year_per_row_%d", perRow), RenderYear(1403, opts))
+		})
+	}
+
+	widest := 0
+	for month := 1; month <= monthsInYear; month++ {
+		widest = max(widest, MonthWidth(1403, month, plain))
+	}
+	// fits is the width of a row of n months
+	fits := func(n int) int { return n*(widest+monthGap) - monthGap }
+
+	t.Run("width", func(t *testing.T) {
+		// One column short of six months leaves five in a row
+		opts := plain
+		opts.Width = fits(6) - 1
+		got := RenderYear(1403, opts)
+		assertGolden(t, "year_width", got)
+		for _, line := range strings.Split(got, "\n") {
+			if w := displayWidth(line); w > opts.Width {
+				t.Errorf("line %q is %d columns wide, over %d", line, w, opts.Width)
+			}
+		}
+	})
+
+	for _, tt := range []struct{ width, perRow int }{{fits(4), 4}, {fits(4) - 1, 3}, {fits(1) - 1, 1}} {
+		t.Run(fmt.Sprintf("width %d", tt.width), func(t *testing.T) {
+			byWidth, byCount := plain, plain
+			byWidth.Width = tt.width
+			byCount.MonthsPerRow = tt.perRow
+			// The year title is centered on the width, so only the month rows below it compare
+			_, got, _ := strings.Cut(RenderYear(1403, byWidth), "\n")
+			_, want, _ := strings.Cut(RenderYear(1403, byCount), "\n")
+			if got != want {
+				t.Errorf("width %d does not place %d months in a row:\n%s\nwant:\n%s", tt.width, tt.perRow, got, want)
+			}
+		})
+	}
+}
+
+func TestYearQuarterLabels(t *testing.T) {
+	for _, tt := range []struct {
+		golden string
+		locale Locale
+		labels []string
+	}{
+		{"year_quarter_labels", LocaleTransliterated, []string{"Bahar", "Tabestan", "Paeez", "Zemestan"}},
+		{"year_quarter_labels_english", LocaleEnglish, []string{"Spring", "Summer", "Autumn", "Winter"}},
+		{"year_quarter_labels_persian", LocalePersian, []string{"بهار", "تابستان", "پاییز", "زمستان"}},
+	} {
+		t.Run(tt.golden, func(t *testing.T) {
+			opts := plain
+			opts.Locale = tt.locale
+			opts.QuarterLabels = true
+			got := RenderYear(1403, opts)
+
+			at := -1
+			for _, label := range tt.labels {
+				next := strings.Index(got, label)
+				if next <= at {
+					t.Fatalf("season %s is missing or out of order:\n%s", label, got)
+				}
+				at = next
+			}
+			assertGolden(t, tt.golden, got)
+		})
+	}
+}
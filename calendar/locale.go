@@ -0,0 +1,151 @@
+package calendar
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Locale selects the language month and weekday names are displayed in
+type Locale int
+
+const (
+	// LocaleTransliterated writes the Persian names in Latin letters, e.g. "Farvardin" and "Shanbe"
+	LocaleTransliterated Locale = iota
+	// LocaleEnglish uses English weekday names and the Gregorian months each Jalali month overlaps
+	LocaleEnglish
+	// LocalePersian writes the names in Persian script
+	LocalePersian
+)
+
+// ParseLocale returns the locale for a language ("en" or "fa") and, for English,
+// the style of its names: "transliteration" or "english"
+func ParseLocale(lang, enStyle string) (Locale, error) {
+	switch lang {
+	case "en":
+		switch enStyle {
+		case "transliteration":
+			return LocaleTransliterated, nil
+		case "english":
+			return LocaleEnglish, nil
+		default:
+			return LocaleTransliterated, fmt.Errorf("unknown English style %q (valid: transliteration, english)", enStyle)
+		}
+	case "fa":
+		return LocalePersian, nil
+	default:
+		return LocaleTransliterated, fmt.Errorf("unknown locale %q (valid: en, fa)", lang)
+	}
+}
+
+// Month names, one table per locale
+
+// monthNames are the transliterated month names
+var monthNames = []string{
+	"Farvardin", "Ordibehesht", "Khordad", "Tir", "Mordad", "Shahrivar",
+	"Mehr", "Aban", "Azar", "Dey", "Bahman", "Esfand",
+}
+
+// englishMonthNames approximate each month by the Gregorian months it overlaps
+var englishMonthNames = []string{
+	"Mar-Apr", "Apr-May", "May-Jun", "Jun-Jul", "Jul-Aug", "Aug-Sep",
+	"Sep-Oct", "Oct-Nov", "Nov-Dec", "Dec-Jan", "Jan-Feb", "Feb-Mar",
+}
+
+var persianMonthNames = []string{
+	"فروردین", "اردیبهشت", "خرداد", "تیر", "مرداد", "شهریور",
+	"مهر", "آبان", "آذر", "دی", "بهمن", "اسفند",
+}
+
+// Season names, one per quarter
+
+// seasonNames are the transliterated season names
+var seasonNames = []string{"Bahar", "Tabestan", "Paeez", "Zemestan"}
+
+var englishSeasonNames = []string{"Spring", "Summer", "Autumn", "Winter"}
+
+var persianSeasonNames = []string{"بهار", "تابستان", "پاییز", "زمستان"}
+
+// Weekday names from Shanbe (Saturday) to Jome (Friday)
+
+// dayNames are the short transliterated names shown above the day columns
+var dayNames = []string{"Shanbe", "Yek", "Do", "Se", "Chahar", "Panj", "Jome"}
+
+// fullDayNames are the complete transliterated weekday names
+var fullDayNames = []string{"Shanbe", "Yekshanbe", "Doshanbe", "Seshanbe", "Chaharshanbe", "Panjshanbe", "Jome"}
+
+var englishDayNames = []string{"Sat", "Sun", "Mon", "Tue", "Wed", "Thu", "Fri"}
+
+var persianDayNames = []string{"شنبه", "یکشنبه", "دوشنبه", "سه‌شنبه", "چهارشنبه", "پنجشنبه", "جمعه"}
+
+// MonthName returns the English transliterated name of a month (1-12)
+func MonthName(month int) string {
+	return monthNames[month-1]
+}
+
+// WeekdayName returns the name of a weekday column (0=Shanbe ... 6=Jome)
+func WeekdayName(weekday int) string {
+	return dayNames[weekday]
+}
+
+// MonthName returns the name of a month (1-12) in the locale
+func (l Locale) MonthName(month int) string {
+	switch l {
+	case LocaleEnglish:
+		return englishMonthNames[month-1]
+	case LocalePersian:
+		return persianMonthNames[month-1]
+	default:
+		return monthNames[month-1]
+	}
+}
+
+// WeekdayName returns the name of a weekday column (0=Shanbe ... 6=Jome) in the locale
+func (l Locale) WeekdayName(weekday int) string {
+	switch l {
+	case LocaleEnglish:
+		return englishDayNames[weekday]
+	case LocalePersian:
+		return persianDayNames[weekday]
+	default:
+		return dayNames[weekday]
+	}
+}
+
+// FullWeekdayName returns the complete name of a weekday (0=Shanbe ... 6=Jome) in
+// the locale, such as Chaharshanbe where WeekdayName gives the column heading Chahar
+func (l Locale) FullWeekdayName(weekday int) string {
+	switch l {
+	case LocaleEnglish:
+		return time.Weekday((weekday + 6) % daysInWeek).String()
+	case LocalePersian:
+		return persianDayNames[weekday]
+	default:
+		return fullDayNames[weekday]
+	}
+}
+
+// SeasonName returns the name of a season (1=Bahar ... 4=Zemestan) in the locale
+func (l Locale) SeasonName(season int) string {
+	switch l {
+	case LocaleEnglish:
+		return englishSeasonNames[season-1]
+	case LocalePersian:
+		return persianSeasonNames[season-1]
+	default:
+		return seasonNames[season-1]
+	}
+}
+
+// GetSeason returns the season of a month (1-12): 1 for Bahar, the first three
+// months, up to 4 for Zemestan
+func GetSeason(month int) int {
+	return (month-1)/monthsInQuarter + 1
+}
+
+// displayWidth returns the number of terminal columns text without ANSI codes
+// occupies. Persian letters take one column each and the zero-width non-joiner none.
+func displayWidth(s string) int {
+	return utf8.RuneCountInString(s) - strings.Count(s, "\u200c")
+}
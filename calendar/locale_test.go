@@ -0,0 +1,27 @@
+package calendar
+
+import "testing"
+
+func TestSeasonName(t *testing.T) {
+	tests := []struct {
+		locale Locale
+		want   [4]string
+	}{
+		{LocaleTransliterated, [4]string{"Bahar", "Tabestan", "Paeez", "Zemestan"}},
+		{LocaleEnglish, [4]string{"Spring", "Summer", "Autumn", "Winter"}},
+		{LocalePersian, [4]string{"بهار", "تابستان", "پاییز", "زمستان"}},
+	}
+	for _, tt := range tests {
+		for season := 1; season <= 4; season++ {
+			if got := tt.locale.SeasonName(season); got != tt.want[season-1] {
+				t.Errorf("locale %d: SeasonName(%d) = %q, want %q", tt.locale, season, got, tt.want[season-1])
+			}
+		}
+		// Each month's season is named like the quarter it opens
+		for month := 1; month <= monthsInYear; month++ {
+			if got, want := tt.locale.SeasonName(GetSeason(month)), tt.want[(month-1)/3]; got != want {
+				t.Errorf("locale %d: season of month %d is %q, want %q", tt.locale, month, got, want)
+			}
+		}
+	}
+}
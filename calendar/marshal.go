@@ -0,0 +1,42 @@
+package calendar
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalJSON writes the date as an ISO string, e.g. "1403-05-12"
+func (d JalaliDate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON reads a date written as "YYYY-MM-DD" (or "YYYY/MM/DD"), rejecting
+// dates that do not exist. A JSON null leaves the date unchanged.
+func (d *JalaliDate) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("jalali date must be a string: %v", err)
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+// MarshalText writes the date as an ISO string, so dates can be used as JSON
+// map keys and with flag.TextVar
+func (d JalaliDate) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText reads a date written as YYYY-MM-DD (or YYYY/MM/DD), rejecting
+// dates that do not exist
+func (d *JalaliDate) UnmarshalText(text []byte) error {
+	date, err := ParseJalali(string(text))
+	if err != nil {
+		return err
+	}
+	*d = date
+	return nil
+}
@@ -0,0 +1,108 @@
+package calendar
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestJalaliDateJSONRoundTrip(t *testing.T) {
+	type entry struct {
+		Name string     `json:"name"`
+		Date JalaliDate `json:"date"`
+	}
+	in := entry{Name: "Nowruz", Date: JalaliDate{1404, 1, 1}}
+
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"name":"Nowruz","date":"1404-01-01"}`; string(data) != want {
+		t.Errorf("json.Marshal = %s, want %s", data, want)
+	}
+
+	var out entry
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out != in {
+		t.Errorf("round trip gave %+v, want %+v", out, in)
+	}
+}
+
+func TestJalaliDateUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    JalaliDate
+		invalid bool
+	}{
+		{`"1403-05-12"`, JalaliDate{1403, 5, 12}, false},
+		{`"1403/12/30"`, JalaliDate{1403, 12, 30}, false},
+		{`null`, JalaliDate{}, false},
+		{`"1402-12-30"`, JalaliDate{}, true}, // 1402 is not a leap year
+		{`"1403-13-01"`, JalaliDate{}, true},
+		{`"not a date"`, JalaliDate{}, true},
+		{`14030512`, JalaliDate{}, true},
+	}
+	for _, tt := range tests {
+		var got JalaliDate
+		err := json.Unmarshal([]byte(tt.input), &got)
+		if tt.invalid {
+			if err == nil {
+				t.Errorf("json.Unmarshal(%s) = %v, want an error", tt.input, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("json.Unmarshal(%s) = %v, %v, want %v", tt.input, got, err, tt.want)
+		}
+	}
+
+	var d JalaliDate
+	if err := json.Unmarshal([]byte(`"1403-13-01"`), &d); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("month 13 gave %v, want ErrOutOfRange", err)
+	}
+}
+
+func TestJalaliDateMapKeys(t *testing.T) {
+	in := map[JalaliDate]string{
+		{1403, 1, 1}:   "Nowruz",
+		{1403, 1, 13}:  "Sizdah Bedar",
+		{1403, 12, 30}: "Last day of 1403",
+	}
+
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"1403-01-01":"Nowruz","1403-01-13":"Sizdah Bedar","1403-12-30":"Last day of 1403"}`
+	if string(data) != want {
+		t.Errorf("json.Marshal = %s, want %s", data, want)
+	}
+
+	var out map[JalaliDate]string
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(out, in) {
+		t.Errorf("round trip gave %v, want %v", out, in)
+	}
+
+	if err := json.Unmarshal([]byte(`{"1403-07-31":"no such day"}`), &out); err == nil {
+		t.Error("a key that is not a date was accepted")
+	}
+}
+
+func TestJalaliDateText(t *testing.T) {
+	d := JalaliDate{1403, 5, 12}
+	text, err := d.MarshalText()
+	if err != nil || string(text) != "1403-05-12" {
+		t.Fatalf("MarshalText = %q, %v, want 1403-05-12", text, err)
+	}
+
+	var got JalaliDate
+	if err := got.UnmarshalText(text); err != nil || got != d {
+		t.Errorf("UnmarshalText(%q) = %v, %v, want %v", text, got, err, d)
+	}
+}
@@ -0,0 +1,82 @@
+package calendar
+
+import (
+	"math"
+	"time"
+)
+
+// equinoxTerms are the periodic terms (A, B, C) of Meeus' vernal equinox correction
+var equinoxTerms = [][3]float64{
+	{485, 324.96, 1934.136}, {203, 337.23, 32964.467}, {199, 342.08, 20.186},
+	{182, 27.85, 445267.112}, {156, 73.14, 45036.886}, {136, 171.52, 22518.443},
+	{77, 222.54, 65928.934}, {74, 296.72, 3034.906}, {70, 243.58, 9037.513},
+	{58, 119.81, 33718.147}, {52, 297.17, 150.678}, {50, 21.02, 2281.226},
+	{45, 247.54, 29929.562}, {44, 325.15, 31555.956}, {29, 60.93, 4443.417},
+	{18, 155.12, 67555.328}, {17, 288.79, 4562.452}, {16, 198.04, 62894.029},
+	{14, 199.76, 31436.921}, {12, 95.39, 14577.848}, {12, 287.11, 31931.756},
+	{12, 320.81, 34777.259}, {9, 227.73, 1222.114}, {8, 15.45, 16859.074},
+}
+
+// unixEpochJD is the Julian Date of 1970-01-01 00:00 UTC
+const unixEpochJD = 2440587.5
+
+// NowruzInstant returns the moment of the vernal equinox that starts the given
+// Jalali year, in UTC. It follows Meeus' "Astronomical Algorithms" (chapter 27),
+// an approximation good to about a minute for years near the present, so the
+// result is rounded to the second.
+func NowruzInstant(jalaliYear int) time.Time {
+	gy := jalaliYear + gregorianOffset
+
+	jde := marchEquinoxJDE(gy)
+	jd := jde - deltaT(float64(gy)+0.2)/86400
+
+	seconds := math.Round((jd - unixEpochJD) * 86400)
+	return time.Unix(int64(seconds), 0).UTC()
+}
+
+// marchEquinoxJDE returns the Julian Ephemeris Day of the March equinox of a Gregorian year
+func marchEquinoxJDE(gy int) float64 {
+	var jde0 float64
+	if gy < 1000 {
+		y := float64(gy) / 1000
+		jde0 = 1721139.29189 + 365242.13740*y + 0.06134*y*y + 0.00111*y*y*y - 0.00071*y*y*y*y
+	} else {
+		y := float64(gy-2000) / 1000
+		jde0 = 2451623.80984 + 365242.37404*y + 0.05169*y*y - 0.00411*y*y*y - 0.00057*y*y*y*y
+	}
+
+	t := (jde0 - 2451545.0) / 36525
+	w := degreesToRadians(35999.373*t - 2.47)
+	dl := 1 + 0.0334*math.Cos(w) + 0.0007*math.Cos(2*w)
+
+	s := 0.0
+	for _, term := range equinoxTerms {
+		s += term[0] * math.Cos(degreesToRadians(term[1]+term[2]*t))
+	}
+
+	return jde0 + 0.00001*s/dl
+}
+
+// deltaT approximates the difference between Terrestrial Time and Universal Time in
+// seconds, using the Espenak and Meeus polynomial expressions
+func deltaT(y float64) float64 {
+	switch {
+	case y >= 1986 && y < 2005:
+		t := y - 2000
+		return 63.86 + 0.3345*t - 0.060374*t*t + 0.0017275*t*t*t + 0.000651814*t*t*t*t + 0.00002373599*t*t*t*t*t
+	case y >= 2005 && y < 2050:
+		t := y - 2000
+		return 62.92 + 0.32217*t + 0.005589*t*t
+	case y >= 2050 && y < 2150:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u - 0.5628*(2150-y)
+	default:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u
+	}
+}
+
+// degreesToRadians converts an angle in degrees to radians
+func degreesToRadians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
@@ -0,0 +1,28 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNowruzInstant(t *testing.T) {
+	// The March equinoxes as published for the start of each year, in UTC
+	published := map[int]time.Time{
+		1400: time.Date(2021, 3, 20, 9, 37, 28, 0, time.UTC),
+		1401: time.Date(2022, 3, 20, 15, 33, 26, 0, time.UTC),
+		1402: time.Date(2023, 3, 20, 21, 24, 28, 0, time.UTC),
+		1403: time.Date(2024, 3, 20, 3, 6, 21, 0, time.UTC),
+		1404: time.Date(2025, 3, 20, 9, 1, 30, 0, time.UTC),
+	}
+	const tolerance = time.Minute
+
+	for year, want := range published {
+		got := NowruzInstant(year)
+		if diff := got.Sub(want).Abs(); diff > tolerance {
+			t.Errorf("NowruzInstant(%d) = %v, want %v within %v (off by %v)", year, got, want, tolerance, diff)
+		}
+		if got.Nanosecond() != 0 {
+			t.Errorf("NowruzInstant(%d) = %v, not rounded to the second", year, got)
+		}
+	}
+}
@@ -0,0 +1,311 @@
+package calendar
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+)
+
+// Alignment controls where a header is placed within the width of its calendar
+type Alignment int
+
+const (
+	AlignCenter Alignment = iota
+	AlignLeft
+	AlignRight
+)
+
+// ParseAlignment converts "left", "center" or "right" to an Alignment
+func ParseAlignment(s string) (Alignment, error) {
+	switch s {
+	case "left":
+		return AlignLeft, nil
+	case "center":
+		return AlignCenter, nil
+	case "right":
+		return AlignRight, nil
+	default:
+		return AlignCenter, fmt.Errorf("unknown alignment %q (valid: left, center, right)", s)
+	}
+}
+
+// DateRange is an inclusive range of Jalali dates
+type DateRange struct {
+	Start JalaliDate
+	End   JalaliDate
+}
+
+// Contains reports whether date falls within the range
+func (r DateRange) Contains(date JalaliDate) bool {
+	return !date.before(r.Start) && !r.End.before(date)
+}
+
+// ParseDateRange parses a range written as "1403-05-01..1403-05-10"
+func ParseDateRange(s string) (DateRange, error) {
+	startStr, endStr, found := strings.Cut(s, "..")
+	if !found {
+		return DateRange{}, fmt.Errorf("invalid range %q, expected START..END", s)
+	}
+
+	start, err := ParseJalali(startStr)
+	if err != nil {
+		return DateRange{}, err
+	}
+	end, err := ParseJalali(endStr)
+	if err != nil {
+		return DateRange{}, err
+	}
+	if end.before(start) {
+		return DateRange{}, fmt.Errorf("invalid range %q, end is before start", s)
+	}
+	return DateRange{Start: start, End: end}, nil
+}
+
+// nowruzWeek returns the first week of Farvardin, the Nowruz holidays
+func nowruzWeek(year int) DateRange {
+	return DateRange{
+		Start: FirstDayOfYear(year),
+		End:   JalaliDate{Year: year, Month: 1, Day: daysInWeek},
+	}
+}
+
+// Options holds the preferences used when rendering calendars.
+// The zero value renders like the command line tool does by default.
+type Options struct {
+	// Today is the date to highlight; the zero value means the current date of
+	// Clock. Set it, e.g. with FromTime, for another time zone.
+	Today JalaliDate
+	// Clock tells the current time when Today is unset; nil uses SystemClock
+	Clock Clock
+	// NoToday disables the automatic highlight of today's date
+	NoToday bool
+	// Highlight lists additional dates to highlight
+	Highlight []JalaliDate
+	// HighlightRanges lists additional date ranges to highlight
+	HighlightRanges []DateRange
+	// HighlightWeekdays highlights every day falling on these weekdays (0=Shanbe ... 6=Jome)
+	HighlightWeekdays []int
+	// HighlightMonthDays highlights these days in every month, such as 25 for a monthly
+	// payday. Days a month does not have fall on its last day instead.
+	HighlightMonthDays []int
+	// NowruzWeek highlights the first week of Farvardin
+	NowruzWeek bool
+	// Events marks the days user defined events fall on and lists them below a single month
+	Events []Event
+	// Locale selects the language of month and weekday names
+	Locale Locale
+	// Theme styles the calendar elements; nil uses DefaultTheme
+	Theme *Theme
+	// Colorful colors each weekday column with a distinct hue
+	Colorful bool
+	// Holidays highlights official holidays and lists them below a single month
+	Holidays bool
+	// NoLegends leaves out the holiday and event legends below a single month,
+	// while the days stay marked
+	NoLegends bool
+	// Weekend is the set of weekdays off, used for the weekend color and the
+	// stats; nil means IranianWeekend
+	Weekend Weekend
+	// Stats prints the weekend, holiday and working day counts below a single month
+	Stats bool
+	// HeaderAlign places the month and year headers; the zero value centers them
+	HeaderAlign Alignment
+	// DayAlign places the day numbers within their columns; the zero value centers them
+	DayAlign Alignment
+	// Width is the number of columns multi-month views are laid out for; it decides
+	// how many months share a row. Zero keeps the default of three per row.
+	Width int
+	// MonthsPerRow is the number of months placed side by side in multi-month views.
+	// Zero derives it from Width.
+	MonthsPerRow int
+	// DayCounts prints the number of days under each month of the year view
+	DayCounts bool
+	// AdjacentDays fills the empty cells before and after a month with the dimmed
+	// days of the previous and next months
+	AdjacentDays bool
+	// FirstDayHighlight styles the days of the first column with Theme.WeekStart,
+	// marking where each week begins whichever weekday that column holds
+	FirstDayHighlight bool
+	// NoPadMonth trims the trailing spaces and blank lines of a single month, for
+	// clean copying. Multi-month views keep their padding, which aligns the months.
+	NoPadMonth bool
+	// FixedHeight always lays a month out in six week rows, adding empty weeks to
+	// shorter months, so that consecutive months shown one after another keep
+	// the same height
+	FixedHeight bool
+	// Columns, when set, are the weekday columns (0 for Shanbe to 6 for Jome) the
+	// calendar tables show; days on the other weekdays are left out, as are weeks
+	// left without a day unless FixedHeight is set. Nil shows every weekday.
+	Columns []int
+	// ReverseWeeks lists the weeks of each month from the last to the first,
+	// below the month header and weekday names
+	ReverseWeeks bool
+	// MarkMonthStart has DisplayGregorianMonth put a labelled separator above the
+	// day each Jalali month begins on
+	MarkMonthStart bool
+	// ShowGregorian adds the Gregorian years a year spans to the year view header
+	ShowGregorian bool
+	// QuarterLabels prints the season name above each quarter of the year view
+	QuarterLabels bool
+	// StartDay, when set, overrides the weekday column (0-6) of the first day
+	// of every month. It exists to reproduce layout issues independently of
+	// the date conversion.
+	StartDay *int
+	// Logger, when set, receives the intermediate conversion values of every rendered month
+	Logger *log.Logger
+	// Output receives the rendered calendar; nil means standard output
+	Output io.Writer
+}
+
+// today returns the date to highlight as today: Today when set, otherwise the
+// date of the clock's time in its own location
+func (o Options) today() JalaliDate {
+	if o.Today == (JalaliDate{}) {
+		return FromTime(o.clock().Now())
+	}
+	return o.Today
+}
+
+// clock returns the Clock deciding the current date
+func (o Options) clock() Clock {
+	if o.Clock == nil {
+		return SystemClock{}
+	}
+	return o.Clock
+}
+
+// output returns the writer the display functions print to
+func (o Options) output() io.Writer {
+	if o.Output == nil {
+		return os.Stdout
+	}
+	return o.Output
+}
+
+// maxWeeksInMonth is the most week rows a month can span, the height of every
+// month with FixedHeight
+const maxWeeksInMonth = 6
+
+// monthCalendar returns the grid for a month, honoring the StartDay override
+// and FixedHeight
+func (o Options) monthCalendar(year, month int) [][]int {
+	var grid [][]int
+	if o.StartDay != nil {
+		grid = buildMonthGrid(GetDaysInMonth(year, month), *o.StartDay)
+	} else {
+		grid = GetMonthCalendar(year, month)
+	}
+
+	for o.FixedHeight && len(grid) < maxWeeksInMonth {
+		grid = append(grid, make([]int, daysInWeek))
+	}
+	return grid
+}
+
+// traceMonth logs the conversion values behind the layout of a month
+func (o Options) traceMonth(year, month int) {
+	if o.Logger == nil {
+		return
+	}
+
+	jCal := jalCal(year)
+	firstDay := JalaliDate{Year: year, Month: month, Day: 1}
+	o.Logger.Printf("%s %d: jalCal leap=%d gy=%d march=%d, first day JDN=%d, first weekday=%d",
+		monthNames[month-1], year, jCal.leap, jCal.gy, jCal.march, JalaliToJDN(firstDay), GetDayOfWeek(year, month, 1))
+}
+
+// HighlightSource names an option that highlights dates
+type HighlightSource string
+
+const (
+	// HighlightDate is a date listed in Options.Highlight
+	HighlightDate HighlightSource = "date"
+	// HighlightRange is a range listed in Options.HighlightRanges
+	HighlightRange HighlightSource = "range"
+	// HighlightMonthDay is a day listed in Options.HighlightMonthDays
+	HighlightMonthDay HighlightSource = "day of month"
+	// HighlightWeekday is a weekday listed in Options.HighlightWeekdays
+	HighlightWeekday HighlightSource = "weekday"
+	// HighlightNowruzWeek is Options.NowruzWeek
+	HighlightNowruzWeek HighlightSource = "Nowruz week"
+)
+
+// highlightChecks tests each highlight source, in the order they are reported
+var highlightChecks = []struct {
+	source HighlightSource
+	match  func(o Options, date JalaliDate) bool
+}{
+	{HighlightDate, func(o Options, date JalaliDate) bool {
+		for _, h := range o.Highlight {
+			if h == date {
+				return true
+			}
+		}
+		return false
+	}},
+	{HighlightRange, func(o Options, date JalaliDate) bool {
+		for _, r := range o.HighlightRanges {
+			if r.Contains(date) {
+				return true
+			}
+		}
+		return false
+	}},
+	{HighlightMonthDay, func(o Options, date JalaliDate) bool {
+		for _, day := range o.HighlightMonthDays {
+			if min(day, GetDaysInMonth(date.Year, date.Month)) == date.Day {
+				return true
+			}
+		}
+		return false
+	}},
+	{HighlightWeekday, func(o Options, date JalaliDate) bool {
+		if len(o.HighlightWeekdays) == 0 {
+			return false
+		}
+		weekday := GetDayOfWeek(date.Year, date.Month, date.Day)
+		for _, w := range o.HighlightWeekdays {
+			if w == weekday {
+				return true
+			}
+		}
+		return false
+	}},
+	{HighlightNowruzWeek, func(o Options, date JalaliDate) bool {
+		return o.NowruzWeek && nowruzWeek(date.Year).Contains(date)
+	}},
+}
+
+// isHighlighted reports whether any highlight option matches a date. Every source
+// adds the same highlight style, so a day matched by several looks the same as a
+// day matched by one.
+func (o Options) isHighlighted(date JalaliDate) bool {
+	for _, check := range highlightChecks {
+		if check.match(o, date) {
+			return true
+		}
+	}
+	return false
+}
+
+// HighlightSources lists the highlight options matching a date, in the order of
+// the HighlightSource constants. It helps spotting options that overlap.
+func (o Options) HighlightSources(date JalaliDate) []HighlightSource {
+	var sources []HighlightSource
+	for _, check := range highlightChecks {
+		if check.match(o, date) {
+			sources = append(sources, check.source)
+		}
+	}
+	return sources
+}
+
+// theme returns the theme used for rendering
+func (o Options) theme() Theme {
+	if o.Theme == nil {
+		return DefaultTheme
+	}
+	return *o.Theme
+}
@@ -0,0 +1,106 @@
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestParseDateRange(t *testing.T) {
+	tests := []struct {
+		in         string
+		want       DateRange
+		outOfRange bool
+		wantErr    bool
+	}{
+		{in: "1403-05-01..1403-05-10", want: DateRange{JalaliDate{1403, 5, 1}, JalaliDate{1403, 5, 10}}},
+		{in: "1403/12/25..1404/01/04", want: DateRange{JalaliDate{1403, 12, 25}, JalaliDate{1404, 1, 4}}},
+		{in: "1403-05-01..1403-05-01", want: DateRange{JalaliDate{1403, 5, 1}, JalaliDate{1403, 5, 1}}},
+		{in: "1403-05-10..1403-05-01", wantErr: true},
+		{in: "1403-05-01", wantErr: true},
+		{in: "1403-05-01...1403-05-10", wantErr: true},
+		{in: "..1403-05-10", wantErr: true},
+		{in: "1403-05-01..", wantErr: true},
+		{in: "1402-12-30..1403-01-05", outOfRange: true},
+		{in: "1403-05-01..1403-13-01", outOfRange: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseDateRange(tt.in)
+		switch {
+		case tt.outOfRange:
+			if !errors.Is(err, ErrOutOfRange) {
+				t.Errorf("ParseDateRange(%q) error %v, want ErrOutOfRange", tt.in, err)
+			}
+		case tt.wantErr:
+			if err == nil {
+				t.Errorf("ParseDateRange(%q) = %v, want an error", tt.in, got)
+			}
+		case err != nil || got != tt.want:
+			t.Errorf("ParseDateRange(%q) = %v, %v, want %v", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestDateRangeContains(t *testing.T) {
+	r := DateRange{Start: JalaliDate{1403, 12, 25}, End: JalaliDate{1404, 1, 4}}
+	tests := []struct {
+		date JalaliDate
+		want bool
+	}{
+		{JalaliDate{1403, 12, 24}, false},
+		{JalaliDate{1403, 12, 25}, true},
+		{JalaliDate{1403, 12, 30}, true},
+		{JalaliDate{1404, 1, 1}, true},
+		{JalaliDate{1404, 1, 4}, true},
+		{JalaliDate{1404, 1, 5}, false},
+		{JalaliDate{1402, 12, 27}, false},
+	}
+	for _, tt := range tests {
+		if got := r.Contains(tt.date); got != tt.want {
+			t.Errorf("%v.Contains(%v) = %v, want %v", r, tt.date, got, tt.want)
+		}
+	}
+
+	if week := nowruzWeek(1404); week.Start != (JalaliDate{1404, 1, 1}) || week.End != (JalaliDate{1404, 1, 7}) {
+		t.Errorf("nowruzWeek(1404) = %v", week)
+	}
+}
+
+func TestRenderHighlightRanges(t *testing.T) {
+	opts := Options{
+		NoToday:         true,
+		HighlightRanges: []DateRange{{Start: JalaliDate{1404, 1, 10}, End: JalaliDate{1404, 1, 12}}},
+		NowruzWeek:      true,
+	}
+	assertGolden(t, "highlight_ranges", RenderMonth(1404, 1, opts))
+}
+
+func TestHighlightSources(t *testing.T) {
+	opts := Options{
+		Highlight:          []JalaliDate{{Year: 1404, Month: 1, Day: 5}},
+		HighlightRanges:    []DateRange{{Start: JalaliDate{Year: 1404, Month: 1, Day: 3}, End: JalaliDate{Year: 1404, Month: 1, Day: 6}}},
+		HighlightMonthDays: []int{5},
+		HighlightWeekdays:  []int{3},
+		NowruzWeek:         true,
+	}
+	tests := []struct {
+		date JalaliDate
+		want []HighlightSource
+	}{
+		// 5 Farvardin 1404 is a Seshanbe matched by every option, reported in the order of the constants
+		{JalaliDate{Year: 1404, Month: 1, Day: 5}, []HighlightSource{HighlightDate, HighlightRange, HighlightMonthDay, HighlightWeekday, HighlightNowruzWeek}},
+		{JalaliDate{Year: 1404, Month: 1, Day: 6}, []HighlightSource{HighlightRange, HighlightNowruzWeek}},
+		{JalaliDate{Year: 1404, Month: 1, Day: 12}, []HighlightSource{HighlightWeekday}},
+		{JalaliDate{Year: 1404, Month: 2, Day: 5}, []HighlightSource{HighlightMonthDay}},
+		{JalaliDate{Year: 1404, Month: 1, Day: 8}, nil},
+	}
+	for _, tt := range tests {
+		got := opts.HighlightSources(tt.date)
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("HighlightSources(%v) = %q, want %q", tt.date, got, tt.want)
+		}
+		if highlighted := opts.isHighlighted(tt.date); highlighted != (len(tt.want) > 0) {
+			t.Errorf("isHighlighted(%v) = %v, want %v", tt.date, highlighted, len(tt.want) > 0)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Layout of the PNG export, in pixels
+const (
+	pngScale   = 3  // size of a font pixel
+	pngCell    = 56 // width and height of a day cell
+	pngMargin  = 16
+	pngHeader  = 48 // height of the month header
+	pngDayName = 32 // height of the weekday names row
+)
+
+// Colors of the PNG export, chosen for a white background
+var (
+	pngBackground = color.RGBA{0xff, 0xff, 0xff, 0xff}
+	pngText       = color.RGBA{0x20, 0x20, 0x20, 0xff}
+	pngHeaderText = color.RGBA{0x1f, 0x4e, 0x9a, 0xff}
+	pngHoliday    = color.RGBA{0xc6, 0x28, 0x28, 0xff}
+	pngToday      = color.RGBA{0xff, 0xe0, 0x82, 0xff}
+	pngGrid       = color.RGBA{0xe0, 0xe0, 0xe0, 0xff}
+)
+
+// ErrNoPersianFont is returned when a PNG is asked for in LocalePersian; the
+// built-in bitmap font only has Latin letters and digits
+var ErrNoPersianFont = errors.New("the PNG export has no font for Persian text")
+
+// RenderMonthPNG draws a month as a PNG image and writes it to w. Today and,
+// with opts.Holidays, the official holidays are highlighted. Text is drawn with
+// a built-in bitmap font, so names are written with LocaleTransliterated or
+// LocaleEnglish; LocalePersian returns ErrNoPersianFont.
+func RenderMonthPNG(w io.Writer, year, month int, opts Options) error {
+	if err := checkMonth(month); err != nil {
+		return err
+	}
+	if opts.Locale == LocalePersian {
+		return ErrNoPersianFont
+	}
+
+	grid := opts.monthCalendar(year, month)
+	today := opts.today()
+
+	width := 2*pngMargin + daysInWeek*pngCell
+	height := 2*pngMargin + pngHeader + pngDayName + len(grid)*pngCell
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), image.NewUniform(pngBackground), image.Point{}, draw.Src)
+
+	locale := opts.Locale
+	header := strings.ToUpper(fmt.Sprintf("%s %d", locale.MonthName(month), year))
+	drawCentered(img, header, image.Rect(pngMargin, pngMargin, width-pngMargin, pngMargin+pngHeader), pngHeaderText)
+
+	top := pngMargin + pngHeader
+	for i := 0; i < daysInWeek; i++ {
+		cell := image.Rect(pngMargin+i*pngCell, top, pngMargin+(i+1)*pngCell, top+pngDayName)
+		// Two letters keep the widest names inside their cell
+		drawCentered(img, strings.ToUpper(locale.WeekdayName(i)[:2]), cell, pngText)
+	}
+
+	top += pngDayName
+	for row, week := range grid {
+		for column, day := range week {
+			cell := image.Rect(pngMargin+column*pngCell, top+row*pngCell, pngMargin+(column+1)*pngCell, top+(row+1)*pngCell)
+			drawBorder(img, cell, pngGrid)
+			if day == 0 {
+				continue
+			}
+
+			date := JalaliDate{Year: year, Month: month, Day: day}
+			if !opts.NoToday && date == today {
+				draw.Draw(img, cell.Inset(1), image.NewUniform(pngToday), image.Point{}, draw.Src)
+			}
+
+			textColor := pngText
+			if opts.Holidays && IsHoliday(date) {
+				textColor = pngHoliday
+			}
+			drawCentered(img, strconv.Itoa(day), cell, textColor)
+		}
+	}
+
+	return png.Encode(w, img)
+}
+
+// drawCentered draws text with the bitmap font centered within r
+func drawCentered(img draw.Image, text string, r image.Rectangle, c color.Color) {
+	advance := (glyphWidth + 1) * pngScale
+	textWidth := len(text)*advance - pngScale
+	x := r.Min.X + (r.Dx()-textWidth)/2
+	y := r.Min.Y + (r.Dy()-glyphHeight*pngScale)/2
+
+	for _, ch := range text {
+		glyph := bitmapFont[ch]
+		for row, bits := range glyph {
+			for col := 0; col < glyphWidth; col++ {
+				if bits&(1<<(glyphWidth-1-col)) == 0 {
+					continue
+				}
+				px := image.Rect(x+col*pngScale, y+row*pngScale, x+(col+1)*pngScale, y+(row+1)*pngScale)
+				draw.Draw(img, px, image.NewUniform(c), image.Point{}, draw.Src)
+			}
+		}
+		x += advance
+	}
+}
+
+// drawBorder draws a one pixel outline along the edges of r
+func drawBorder(img draw.Image, r image.Rectangle, c color.Color) {
+	for x := r.Min.X; x < r.Max.X; x++ {
+		img.Set(x, r.Min.Y, c)
+		img.Set(x, r.Max.Y-1, c)
+	}
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		img.Set(r.Min.X, y, c)
+		img.Set(r.Max.X-1, y, c)
+	}
+}
@@ -0,0 +1,58 @@
+package calendar
+
+import (
+	"bytes"
+	"errors"
+	"image/png"
+	"testing"
+)
+
+func TestRenderMonthPNG(t *testing.T) {
+	var buf bytes.Buffer
+	opts := Options{Today: JalaliDate{1403, 5, 12}, Holidays: true}
+	if err := RenderMonthPNG(&buf, 1403, 5, opts); err != nil {
+		t.Fatal(err)
+	}
+
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatalf("not a PNG image: %v", err)
+	}
+	weeks := len(GetMonthCalendar(1403, 5))
+	wantWidth, wantHeight := 2*pngMargin+daysInWeek*pngCell, 2*pngMargin+pngHeader+pngDayName+weeks*pngCell
+	if b := img.Bounds(); b.Dx() != wantWidth || b.Dy() != wantHeight {
+		t.Errorf("image is %dx%d, want %dx%d", b.Dx(), b.Dy(), wantWidth, wantHeight)
+	}
+
+	// 12 Mordad 1403 is on the second week row, in the Jome column
+	x, y := pngMargin+6*pngCell+3, pngMargin+pngHeader+pngDayName+1*pngCell+3
+	if got := img.At(x, y); got != pngToday {
+		t.Errorf("today's cell is %v, want %v", got, pngToday)
+	}
+	if got := img.At(x-pngCell, y); got != pngBackground {
+		t.Errorf("the day before today is %v, want %v", got, pngBackground)
+	}
+}
+
+func TestRenderMonthPNGErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		month int
+		opts  Options
+		want  error
+	}{
+		{"persian", 5, Options{Locale: LocalePersian}, ErrNoPersianFont},
+		{"month 13", 13, Options{}, ErrOutOfRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := RenderMonthPNG(&buf, 1403, tt.month, tt.opts); !errors.Is(err, tt.want) {
+				t.Errorf("RenderMonthPNG = %v, want %v", err, tt.want)
+			}
+			if buf.Len() != 0 {
+				t.Errorf("wrote %d bytes despite the error", buf.Len())
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package calendar
+
+import "fmt"
+
+// MonthStats counts the kinds of days in a month for work planning
+type MonthStats struct {
+	// WeekendDays is the number of days falling on the weekend
+	WeekendDays int `json:"weekend_days"`
+	// Holidays is the number of official holidays, including those on a weekend
+	Holidays int `json:"holidays"`
+	// WorkingDays is the number of days that are neither weekend days nor holidays
+	WorkingDays int `json:"working_days"`
+}
+
+// GetMonthStats returns the weekend, holiday and working day counts of a month,
+// with Jome as the weekend
+func GetMonthStats(year, month int) MonthStats {
+	return GetMonthStatsWithWeekend(year, month, IranianWeekend)
+}
+
+// GetMonthStatsWithWeekend returns the weekend, holiday and working day counts of
+// a month for the given weekend
+func GetMonthStatsWithWeekend(year, month int, weekend Weekend) MonthStats {
+	var stats MonthStats
+	firstDay := GetDayOfWeek(year, month, 1)
+	for day := 1; day <= GetDaysInMonth(year, month); day++ {
+		dayOff := weekend.contains((firstDay + day - 1) % daysInWeek)
+		holiday := IsHoliday(JalaliDate{Year: year, Month: month, Day: day})
+
+		if dayOff {
+			stats.WeekendDays++
+		}
+		if holiday {
+			stats.Holidays++
+		}
+		if !dayOff && !holiday {
+			stats.WorkingDays++
+		}
+	}
+	return stats
+}
+
+// String returns the stats as the line printed below a month
+func (s MonthStats) String() string {
+	return fmt.Sprintf("Weekend days: %d  Holidays: %d  Working days: %d", s.WeekendDays, s.Holidays, s.WorkingDays)
+}
@@ -1,35 +1,103 @@
 package calendar
 
 import (
-	"bytes"
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
 	"time"
-
-	"github.com/olekukonko/tablewriter"
+	"unicode/utf8"
 )
 
 const (
-	// colors
-	todayColor  = "\033[1;33m" // bold yellow for today's date
-	headerColor = "\033[1;36m" // bold cyan for month/year header
-	resetColor  = "\033[0m"
+	resetColor = "\033[0m"
+	// dimColor styles secondary information such as the day count footers
+	dimColor = "2"
 
 	// calendar constants
 	daysInWeek      = 7
 	monthsInYear    = 12
-	quartersInYear  = 4
 	monthsInQuarter = 3
+
+	// monthGap is the number of spaces between months placed side by side
+	monthGap = 2
 )
 
+// weekdayColors holds one color per weekday column for the colorful mode
+var weekdayColors = []string{
+	"31", // red
+	"33", // yellow
+	"32", // green
+	"36", // cyan
+	"34", // blue
+	"35", // magenta
+	"91", // bright red
+}
+
+// ParseMonthName returns the number (1-12) of a month given its English
+// transliterated or Persian name. The match is case-insensitive.
+func ParseMonthName(name string) (int, error) {
+	name = normalizePersian(strings.TrimSpace(name))
+	for i := range monthNames {
+		if strings.EqualFold(name, monthNames[i]) || name == persianMonthNames[i] {
+			return i + 1, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown month name %q (valid names: %s)", name, strings.Join(monthNames, ", "))
+}
 
-var monthNames = []string{
-	"Farvardin", "Ordibehesht", "Khordad", "Tir", "Mordad", "Shahrivar",
-	"Mehr", "Aban", "Azar", "Dey", "Bahman", "Esfand",
+// ParseWeekdayName returns the weekday (0=Shanbe ... 6=Jome) given its short or
+// full transliterated name or its Persian name. The match is case-insensitive.
+func ParseWeekdayName(name string) (int, error) {
+	name = normalizePersian(strings.TrimSpace(name))
+
+	// Persian names are written with or without the zero-width non-joiner in سه‌شنبه
+	joined := strings.NewReplacer("\u200c", "", " ", "")
+	for i := range dayNames {
+		if strings.EqualFold(name, dayNames[i]) || strings.EqualFold(name, fullDayNames[i]) ||
+			joined.Replace(name) == joined.Replace(persianDayNames[i]) {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown weekday name %q (valid names: %s)", name, strings.Join(fullDayNames, ", "))
 }
 
-var dayNames = []string{"Shanbe", "Yek", "Do", "Se", "Chahar", "Panj", "Jome"}
+// ToASCII transliterates rendered output for consoles that cannot display UTF-8.
+// Persian month, season and weekday names become their English transliteration,
+// Persian digits become ASCII digits, the en dash a hyphen and any other non-ASCII
+// character is replaced by '?'.
+func ToASCII(s string) string {
+	for i, name := range persianMonthNames {
+		s = strings.ReplaceAll(s, name, monthNames[i])
+	}
+	for i, name := range persianSeasonNames {
+		s = strings.ReplaceAll(s, name, seasonNames[i])
+	}
+	// Longest first, as شنبه is also the ending of the other names
+	for i := len(persianDayNames) - 1; i >= 0; i-- {
+		s = strings.ReplaceAll(s, persianDayNames[i], dayNames[i])
+	}
+
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r < 0x80:
+			return r
+		case r >= '۰' && r <= '۹':
+			return '0' + (r - '۰')
+		case r >= '٠' && r <= '٩':
+			return '0' + (r - '٠')
+		case r == '–':
+			return '-'
+		default:
+			return '?'
+		}
+	}, s)
+}
+
+// normalizePersian replaces Arabic letter variants commonly typed on Arabic keyboards with their Persian forms
+func normalizePersian(s string) string {
+	return strings.NewReplacer("ي", "ی", "ك", "ک").Replace(s)
+}
 
 // stripANSI removes ANSI color codes from a string for accurate width calculation
 func stripANSI(s string) string {
@@ -51,86 +119,292 @@ func stripANSI(s string) string {
 	return result.String()
 }
 
-// createTable creates a new table with common configuration
-func createTable() (*tablewriter.Table, *bytes.Buffer) {
-	buf := &bytes.Buffer{}
-	table := tablewriter.NewWriter(buf)
+// truncateANSI cuts s down to at most width visible columns. Color codes are copied
+// whole, never cut mid-escape, and a reset is added when the cut leaves a color open.
+// Invalid UTF-8, such as a rune cut in half, is left out.
+func truncateANSI(s string, width int) string {
+	var result strings.Builder
+	visible := 0
+	open := false
+	for i := 0; i < len(s); {
+		if s[i] == '\033' {
+			end := strings.IndexByte(s[i:], 'm')
+			if end < 0 {
+				// Drop an escape that never ends rather than print half of it
+				break
+			}
+			code := s[i : i+end+1]
+			open = code != resetColor
+			result.WriteString(code)
+			i += end + 1
+			continue
+		}
 
-	table.SetHeader(dayNames)
-	table.SetBorder(false)
-	table.SetCenterSeparator("")
-	table.SetColumnSeparator("")
-	table.SetRowSeparator("")
-	table.SetHeaderLine(false)
-	table.SetAlignment(tablewriter.ALIGN_CENTER)
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			// Skip the bytes of a rune cut in half, so the result stays valid UTF-8
+			i++
+			continue
+		}
+		char := s[i : i+size]
+		if displayWidth(char) > 0 && visible == width {
+			break
+		}
+		visible += displayWidth(char)
+		result.WriteString(char)
+		i += size
+	}
 
-	// Set header colors (bright white)
-	headerColors := make([]tablewriter.Colors, daysInWeek)
-	for i := range headerColors {
-		headerColors[i] = tablewriter.Colors{tablewriter.FgHiWhiteColor, tablewriter.Bold}
+	if open {
+		result.WriteString(resetColor)
 	}
-	table.SetHeaderColor(headerColors...)
+	return result.String()
+}
 
-	return table, buf
+// weekdayHeader returns the styled weekday names heading the columns of a month
+func weekdayHeader(opts Options) []string {
+	header := make([]string, daysInWeek)
+	for i := range header {
+		header[i] = paint(opts.theme().Weekday, strings.ToUpper(opts.Locale.WeekdayName(i)))
+	}
+	return header
 }
 
-// formatDay formats a day number with optional highlighting for today
-func formatDay(day int, isToday bool) string {
+// formatDay formats a day number, styled with an SGR code when one is given
+func formatDay(day int, color string) string {
 	if day == 0 {
 		return ""
 	}
+	return paint(color, strconv.Itoa(day))
+}
+
+// dayColor returns the SGR code of a day cell. Today's style replaces all others.
+// Otherwise the base color is taken from holidays, weekends or the column color,
+// in that order. The week start, event and highlight styles are added on top of it.
+func dayColor(date JalaliDate, column int, event bool, opts Options) string {
+	theme := opts.theme()
+	if !opts.NoToday && date == opts.Today {
+		return theme.Today
+	}
+
+	var color string
+	switch {
+	case opts.Holidays && IsHoliday(date):
+		color = theme.Holiday
+	case theme.Weekend != "" && opts.Weekend.contains(column):
+		color = theme.Weekend
+	case opts.Colorful:
+		color = weekdayColors[column]
+	}
+
+	if opts.FirstDayHighlight && column == 0 {
+		color = joinCodes(color, theme.WeekStart)
+	}
+	if event {
+		color = joinCodes(color, theme.Event)
+	}
+	if opts.isHighlighted(date) {
+		color = joinCodes(color, theme.Highlight)
+	}
+	return color
+}
+
+// joinCodes combines SGR codes so their attributes apply together
+func joinCodes(codes ...string) string {
+	nonEmpty := make([]string, 0, len(codes))
+	for _, code := range codes {
+		if code != "" {
+			nonEmpty = append(nonEmpty, code)
+		}
+	}
+	return strings.Join(nonEmpty, ";")
+}
+
+// monthRows returns the weeks of a month as rows of styled day cells
+func monthRows(year, month int, opts Options) [][]string {
+	opts.Today = opts.today()
+	calendar := opts.monthCalendar(year, month)
+	opts.traceMonth(year, month)
+
+	// Expand recurring events only over the displayed month
+	eventDays := map[int]bool{}
+	for _, o := range ExpandEvents(opts.Events, monthRange(year, month)) {
+		eventDays[o.Date.Day] = true
+	}
+
+	var cells [][]CalendarCell
+	if opts.AdjacentDays {
+		cells = fillAdjacent(year, month, calendar)
+	}
+
+	rows := make([][]string, len(calendar))
+	for w, week := range calendar {
+		row := make([]string, daysInWeek)
+		for i, day := range week {
+			if day == 0 {
+				if cells != nil {
+					row[i] = formatDay(cells[w][i].Date.Day, dimColor)
+				}
+				continue
+			}
+			row[i] = formatDay(day, dayColor(JalaliDate{Year: year, Month: month, Day: day}, i, eventDays[day], opts))
+		}
+		rows[w] = row
+	}
 
-	dayStr := strconv.Itoa(day)
-	if isToday {
-		return todayColor + dayStr + resetColor
+	if opts.ReverseWeeks {
+		for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+			rows[i], rows[j] = rows[j], rows[i]
+		}
 	}
-	return dayStr
+	return rows
 }
 
 // calculateTableWidth calculates the maximum width of table lines (excluding ANSI codes)
 func calculateTableWidth(lines []string) int {
 	maxWidth := 0
 	for _, line := range lines {
-		cleanLine := stripANSI(line)
-		if len(cleanLine) > maxWidth {
-			maxWidth = len(cleanLine)
-		}
+		maxWidth = max(maxWidth, visibleWidth(line))
 	}
 	return maxWidth
 }
 
-// centerText centers text within a given width
-func centerText(text string, width int) string {
-	padding := (width - len(text)) / 2
+// visibleWidth returns the display width of s ignoring its ANSI color codes. It
+// gives the same result as displayWidth(stripANSI(s)) without building a copy.
+func visibleWidth(s string) int {
+	width := 0
+	inEscape := false
+	for _, r := range s {
+		switch {
+		case r == '\033':
+			inEscape = true
+		case inEscape:
+			inEscape = r != 'm'
+		case r != '\u200c':
+			width++
+		}
+	}
+	return width
+}
+
+// alignText pads text so it is placed within a given width according to mode
+func alignText(text string, width int, mode Alignment) string {
+	padding := width - displayWidth(text)
 	if padding < 0 {
 		padding = 0
 	}
-	return strings.Repeat(" ", padding) + text
+
+	switch mode {
+	case AlignLeft:
+		return text
+	case AlignRight:
+		return strings.Repeat(" ", padding) + text
+	default:
+		return strings.Repeat(" ", padding/2) + text
+	}
 }
 
-// renderMonthAsLines renders a single month as a slice of strings, with colored header and today highlight
-func renderMonthAsLines(year, month int, currentDate JalaliDate) []string {
-	calendar := GetMonthCalendar(year, month)
+// renderTable renders the weekday names and weeks of a month and returns its lines
+func renderTable(year, month int, opts Options) []string {
+	header, rows := weekdayHeader(opts), monthRows(year, month, opts)
+	if opts.Columns != nil {
+		header, rows = filterColumns(header, rows, opts)
+	}
+	return formatGrid(header, rows, opts.DayAlign)
+}
 
-	table, buf := createTable()
+// filterColumns keeps the weekday columns listed in opts.Columns, in weekday
+// order, and drops the weeks that are left empty
+func filterColumns(header []string, rows [][]string, opts Options) ([]string, [][]string) {
+	shown := make([]bool, daysInWeek)
+	for _, column := range opts.Columns {
+		shown[column] = true
+	}
 
-	// Add calendar rows
-	for _, week := range calendar {
-		row := make([]string, daysInWeek)
-		for i, day := range week {
-			isToday := day == currentDate.Day && month == currentDate.Month && year == currentDate.Year
-			row[i] = formatDay(day, isToday)
+	keep := func(cells []string) ([]string, bool) {
+		var kept []string
+		empty := true
+		for i, cell := range cells {
+			if shown[i] {
+				kept = append(kept, cell)
+				empty = empty && cell == ""
+			}
+		}
+		return kept, empty
+	}
+
+	header, _ = keep(header)
+	var filtered [][]string
+	for _, row := range rows {
+		if kept, empty := keep(row); !empty || opts.FixedHeight {
+			filtered = append(filtered, kept)
+		}
+	}
+	return header, filtered
+}
+
+// formatGrid lays a header and rows of cells out as a borderless table and
+// returns its lines. Every column is as wide as its widest cell, measured without
+// color codes, and every cell is framed by a space on each side, with one more
+// space at the start and end of each line. The header is centered; the rows
+// follow mode. All lines have the same width, which the layout of multi-month
+// views relies on.
+func formatGrid(header []string, rows [][]string, mode Alignment) []string {
+	widths := make([]int, len(header))
+	for i, name := range header {
+		widths[i] = visibleWidth(name)
+	}
+	for _, row := range rows {
+		for i, cell := range row {
+			widths[i] = max(widths[i], visibleWidth(cell))
+		}
+	}
+
+	lines := make([]string, 0, len(rows)+1)
+	lines = append(lines, gridLine(header, widths, AlignCenter))
+	for _, row := range rows {
+		lines = append(lines, gridLine(row, widths, mode))
+	}
+	return lines
+}
+
+// gridLine writes one line of the grid, padding each cell to its column width
+func gridLine(cells []string, widths []int, mode Alignment) string {
+	line := &strings.Builder{}
+	line.WriteString(" ")
+	for i, cell := range cells {
+		padding := widths[i] - visibleWidth(cell)
+		left := 0
+		switch mode {
+		case AlignRight:
+			left = padding
+		case AlignCenter:
+			left = padding / 2
 		}
-		table.Append(row)
+
+		line.WriteString(" ")
+		line.WriteString(strings.Repeat(" ", left))
+		line.WriteString(cell)
+		line.WriteString(strings.Repeat(" ", padding-left))
+		line.WriteString(" ")
 	}
+	line.WriteString(" ")
+	return line.String()
+}
 
-	table.Render()
-	tableLines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+// renderMonthAsLines renders a single month as a slice of strings, with colored header and today highlight.
+// showYear adds the year to the header, for views where months may belong to different years.
+func renderMonthAsLines(year, month int, opts Options, showYear bool) []string {
+	tableLines := renderTable(year, month, opts)
 
-	// Calculate table width and center month header
+	// Calculate table width and align month header
 	tableWidth := calculateTableWidth(tableLines)
-	monthHeader := centerText(monthNames[month-1], tableWidth)
-	monthHeaderLine := headerColor + monthHeader + resetColor
+	monthHeader := opts.Locale.MonthName(month)
+	if showYear {
+		monthHeader = fmt.Sprintf("%s %d", monthHeader, year)
+	}
+	monthHeader = alignText(monthHeader, tableWidth, opts.HeaderAlign)
+	monthHeaderLine := paint(opts.theme().Header, monthHeader)
 
 	// Compose the final lines
 	lines := []string{monthHeaderLine}
@@ -138,70 +412,126 @@ func renderMonthAsLines(year, month int, currentDate JalaliDate) []string {
 	return lines
 }
 
-// DisplayMonthTable displays a single month calendar using tablewriter
-func DisplayMonthTable(year, month int, currentDate JalaliDate) {
-	calendar := GetMonthCalendar(year, month)
+// writeOutput writes the rendered calendar to the configured output. With
+// opts.Width set, lines that would not fit, such as long legend entries, are cut.
+func writeOutput(opts Options, s string) error {
+	if opts.Width > 0 {
+		lines := strings.Split(s, "\n")
+		for i, line := range lines {
+			lines[i] = truncateANSI(line, opts.Width)
+		}
+		s = strings.Join(lines, "\n")
+	}
+	_, err := io.WriteString(opts.output(), s)
+	return err
+}
 
-	table, buf := createTable()
+// RenderMonth returns a single month calendar, including its colored header,
+// as a string. month must be between 1 and 12.
+func RenderMonth(year, month int, opts Options) string {
+	tableLines := renderTable(year, month, opts)
 
-	// Add calendar rows
-	for _, week := range calendar {
-		row := make([]string, daysInWeek)
-		for i, day := range week {
-			isToday := day == currentDate.Day && month == currentDate.Month && year == currentDate.Year
-			row[i] = formatDay(day, isToday)
+	// Calculate table width and align header
+	tableWidth := calculateTableWidth(tableLines)
+	header := fmt.Sprintf("%s %d", opts.Locale.MonthName(month), year)
+	header = alignText(header, tableWidth, opts.HeaderAlign)
+
+	output := paint(opts.theme().Header, header) + "\n" + strings.Join(tableLines, "\n") + "\n"
+	if opts.Holidays && !opts.NoLegends {
+		output += renderHolidayLegend(year, month, opts)
+	}
+	if len(opts.Events) > 0 && !opts.NoLegends {
+		output += renderEventLegend(year, month, opts)
+	}
+	if opts.Stats {
+		output += "\n" + GetMonthStatsWithWeekend(year, month, opts.Weekend).String() + "\n"
+	}
+	return output
+}
+
+// renderHolidayLegend lists the holidays of a month with their names and, for
+// coordinating with the Gregorian calendar, the Gregorian date they fall on
+func renderHolidayLegend(year, month int, opts Options) string {
+	legend := &strings.Builder{}
+	for day := 1; day <= GetDaysInMonth(year, month); day++ {
+		date := JalaliDate{Year: year, Month: month, Day: day}
+		holidays := HolidaysOn(date)
+		if len(holidays) == 0 {
+			continue
 		}
-		table.Append(row)
+
+		names := make([]string, len(holidays))
+		for i, h := range holidays {
+			names[i] = h.LocalName(opts.Locale)
+		}
+		gy, gm, gd := JalaliToGregorian(date.Year, date.Month, date.Day)
+		fmt.Fprintf(legend, "  %s  %s (%04d-%02d-%02d)\n", paint(opts.theme().Holiday, fmt.Sprintf("%2d", day)), strings.Join(names, ", "), gy, gm, gd)
 	}
 
-	table.Render()
-	tableOutput := buf.String()
-	tableLines := strings.Split(tableOutput, "\n")
+	if legend.Len() == 0 {
+		return ""
+	}
+	return "\n" + legend.String()
+}
 
-	// Calculate table width and center header
-	tableWidth := calculateTableWidth(tableLines)
-	centeredHeader := fmt.Sprintf("%s %d", monthNames[month-1], year)
-	centeredHeader = centerText(centeredHeader, tableWidth)
+// renderEventLegend lists the events falling in a month
+func renderEventLegend(year, month int, opts Options) string {
+	legend := &strings.Builder{}
+	for _, o := range ExpandEvents(opts.Events, monthRange(year, month)) {
+		fmt.Fprintf(legend, "  %s  %s\n", paint(opts.theme().Event, fmt.Sprintf("%2d", o.Date.Day)), o.Name)
+	}
 
-	fmt.Printf("%s%s%s\n", headerColor, centeredHeader, resetColor)
-	fmt.Print(tableOutput)
+	if legend.Len() == 0 {
+		return ""
+	}
+	return "\n" + legend.String()
 }
 
-// getAdjacentMonths calculates the previous and next months for a given month/year
-func getAdjacentMonths(year, month int) (prevYear, prevMonth, nextYear, nextMonth int) {
-	prevMonth = month - 1
-	prevYear = year
-	if prevMonth < 1 {
-		prevMonth = monthsInYear
-		prevYear--
+// MonthWidth returns the number of columns the table of a month rendered by
+// RenderMonth occupies. The legends below it are left out, as they may be cut.
+func MonthWidth(year, month int, opts Options) int {
+	opts.Holidays, opts.Events, opts.Stats = false, nil, false
+	return calculateTableWidth(strings.Split(RenderMonth(year, month, opts), "\n"))
+}
+
+// DisplayMonthTable displays a single month calendar
+func DisplayMonthTable(year, month int, opts Options) error {
+	if err := checkMonth(month); err != nil {
+		return err
 	}
 
-	nextMonth = month + 1
-	nextYear = year
-	if nextMonth > monthsInYear {
-		nextMonth = 1
-		nextYear++
+	output := RenderMonth(year, month, opts)
+	if opts.NoPadMonth {
+		output = trimTrailingSpace(output)
 	}
+	return writeOutput(opts, output)
+}
 
-	return prevYear, prevMonth, nextYear, nextMonth
+// trimTrailingSpace removes the spaces ending each line and the blank lines at the end
+func trimTrailingSpace(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n") + "\n"
+}
+
+// ShiftMonth returns the month delta months after the given one, or before it
+// for a negative delta, wrapping across years
+func ShiftMonth(year, month, delta int) (int, int) {
+	index := year*monthsInYear + (month - 1) + delta
+	return index / monthsInYear, index%monthsInYear + 1
 }
 
 // padMonthLines ensures all month lines have the same height and consistent width
 func padMonthLines(monthLines [][]string, maxLines int) {
 	for i := range monthLines {
 		// Find the maximum width for this month
-		maxWidth := 0
-		for _, line := range monthLines[i] {
-			cleanLine := stripANSI(line)
-			if len(cleanLine) > maxWidth {
-				maxWidth = len(cleanLine)
-			}
-		}
+		maxWidth := calculateTableWidth(monthLines[i])
 
 		// Pad each line to the maximum width
 		for j := range monthLines[i] {
-			cleanLine := stripANSI(monthLines[i][j])
-			padding := maxWidth - len(cleanLine)
+			padding := maxWidth - visibleWidth(monthLines[i][j])
 			monthLines[i][j] = monthLines[i][j] + strings.Repeat(" ", padding)
 		}
 
@@ -213,111 +543,270 @@ func padMonthLines(monthLines [][]string, maxLines int) {
 }
 
 // DisplayThreeMonthsTable displays three months using colored, aligned tables
-func DisplayThreeMonthsTable(year, month int) {
-	now := time.Now()
-	currentJalali := GregorianToJalali(now.Year(), int(now.Month()), now.Day())
-
-	// Calculate previous and next months
-	prevYear, prevMonth, nextYear, nextMonth := getAdjacentMonths(year, month)
+func DisplayThreeMonthsTable(year, month int, opts Options) error {
+	if err := checkMonth(month); err != nil {
+		return err
+	}
 
-	// Render three months as lines
-	monthLines := make([][]string, 3)
-	maxLines := 0
+	// Start with the previous month so the given one is in the middle
+	prevYear, prevMonth := ShiftMonth(year, month, -1)
+	return displayMonths(prevYear, prevMonth, monthsInQuarter, opts)
+}
 
-	// Previous month
-	monthLines[0] = renderMonthAsLines(prevYear, prevMonth, currentJalali)
-	if len(monthLines[0]) > maxLines {
-		maxLines = len(monthLines[0])
+// DisplayMonthsAhead displays count months starting with the given one, for
+// planning ahead. Months past Esfand continue into the next year.
+func DisplayMonthsAhead(year, month, count int, opts Options) error {
+	if err := checkMonth(month); err != nil {
+		return err
 	}
+	if count < 1 {
+		return fmt.Errorf("month count %d: %w", count, ErrOutOfRange)
+	}
+	return displayMonths(year, month, count, opts)
+}
 
-	// Current month
-	monthLines[1] = renderMonthAsLines(year, month, currentJalali)
-	if len(monthLines[1]) > maxLines {
-		maxLines = len(monthLines[1])
+// DisplayMonthSpan displays the months from first to last months away from the
+// given one, e.g. -2 and 2 for the five months centered on it. Negative offsets
+// reach back across Nowruz into the previous year, positive ones forward.
+func DisplayMonthSpan(year, month, first, last int, opts Options) error {
+	if err := checkMonth(month); err != nil {
+		return err
 	}
+	if last < first {
+		return fmt.Errorf("month span %d..%d: %w", first, last, ErrOutOfRange)
+	}
+
+	startYear, startMonth := ShiftMonth(year, month, first)
+	return displayMonths(startYear, startMonth, last-first+1, opts)
+}
 
-	// Next month
-	monthLines[2] = renderMonthAsLines(nextYear, nextMonth, currentJalali)
-	if len(monthLines[2]) > maxLines {
-		maxLines = len(monthLines[2])
+// displayMonths displays count consecutive months side by side, from the given one on
+func displayMonths(year, month, count int, opts Options) error {
+	// Resolve today once so every month highlights the same date
+	opts.Today = opts.today()
+
+	monthLines := make([][]string, count)
+	for i := range monthLines {
+		y, m := ShiftMonth(year, month, i)
+		monthLines[i] = renderMonthAsLines(y, m, opts, true)
 	}
 
-	// Pad months to same height and ensure consistent width
-	padMonthLines(monthLines, maxLines)
+	rows, _ := layoutMonths(monthLines, monthsPerRow(monthLines, opts, monthsInQuarter))
 
-	// Print side by side with consistent spacing
-	for line := 0; line < maxLines; line++ {
-		fmt.Printf("%s  %s  %s\n", monthLines[0][line], monthLines[1][line], monthLines[2][line])
+	out := &strings.Builder{}
+	for i, row := range rows {
+		if i > 0 {
+			out.WriteString("\n")
+		}
+		out.WriteString(strings.Join(row, "\n") + "\n")
 	}
+	return writeOutput(opts, out.String())
 }
 
-// calculateQuarterWidth calculates the total width of a quarter (3 months)
-func calculateQuarterWidth(allMonthLines [][]string, quarter int) int {
-	quarterWidth := 0
-	for i := 0; i < monthsInQuarter; i++ {
-		monthIdx := quarter*monthsInQuarter + i
-		monthWidth := 0
-		for _, line := range allMonthLines[monthIdx] {
-			cleanLine := stripANSI(line)
-			if len(cleanLine) > monthWidth {
-				monthWidth = len(cleanLine)
+// monthsPerRow returns how many month blocks are placed side by side: opts.MonthsPerRow
+// when set, otherwise as many as fit in opts.Width, or defaultPerRow without a width.
+func monthsPerRow(monthLines [][]string, opts Options, defaultPerRow int) int {
+	perRow := defaultPerRow
+	switch {
+	case opts.MonthsPerRow > 0:
+		perRow = opts.MonthsPerRow
+	case opts.Width > 0:
+		widest := 0
+		for _, lines := range monthLines {
+			widest = max(widest, calculateTableWidth(lines))
+		}
+		perRow = (opts.Width + monthGap) / (widest + monthGap)
+	}
+	return max(1, min(perRow, len(monthLines)))
+}
+
+// layoutMonths arranges month blocks in rows of perRow months, side by side with
+// consistent spacing. It returns the lines of each row and the widest row's width.
+func layoutMonths(monthLines [][]string, perRow int) ([][]string, int) {
+	// Pad every month to the height of the tallest so rows line up
+	maxLines := 0
+	for _, lines := range monthLines {
+		maxLines = max(maxLines, len(lines))
+	}
+
+	var rows [][]string
+	width := 0
+	for start := 0; start < len(monthLines); start += perRow {
+		rowMonths := monthLines[start:min(start+perRow, len(monthLines))]
+
+		// Pad months to same height and ensure consistent width
+		padMonthLines(rowMonths, maxLines)
+
+		row := make([]string, maxLines)
+		for line := range row {
+			parts := make([]string, len(rowMonths))
+			for i, lines := range rowMonths {
+				parts[i] = lines[line]
 			}
+			row[line] = strings.Join(parts, strings.Repeat(" ", monthGap))
 		}
-		quarterWidth += monthWidth + 2 // +2 for spacing between months
+
+		rows = append(rows, row)
+		width = max(width, calculateTableWidth(row))
 	}
-	return quarterWidth
+	return rows, width
 }
 
-// DisplayYearTable displays the entire year using colored, aligned tables
-func DisplayYearTable(year int) {
-	now := time.Now()
-	currentJalali := GregorianToJalali(now.Year(), int(now.Month()), now.Day())
+// seasonLabel names the seasons of the months (0-based, first to last inclusive) in a row
+func seasonLabel(first, last, year int, locale Locale) string {
+	var seasons []string
+	for month := first; month <= last; month++ {
+		season := locale.SeasonName(GetSeason(month + 1))
+		if len(seasons) == 0 || seasons[len(seasons)-1] != season {
+			seasons = append(seasons, season)
+		}
+	}
+	return fmt.Sprintf("%s %d", strings.Join(seasons, " / "), year)
+}
+
+// gregorianYears returns the Gregorian years a Jalali year spans, e.g. "(2024–2025)"
+func gregorianYears(year int) string {
+	first := FirstDayOfYear(year)
+	last := LastDayOfYear(year)
+	firstYear, _, _ := JalaliToGregorian(first.Year, first.Month, first.Day)
+	lastYear, _, _ := JalaliToGregorian(last.Year, last.Month, last.Day)
+	return fmt.Sprintf("(%d–%d)", firstYear, lastYear)
+}
 
+// renderYear renders the entire year as colored, aligned tables and returns it with its width
+func renderYear(year int, opts Options) (string, int) {
 	// First, render all months to calculate the total width
 	allMonthLines := make([][]string, monthsInYear)
-	maxLines := 0
 	for i := 0; i < monthsInYear; i++ {
-		month := i + 1
-		lines := renderMonthAsLines(year, month, currentJalali)
-		allMonthLines[i] = lines
-		if len(lines) > maxLines {
-			maxLines = len(lines)
-		}
+		allMonthLines[i] = renderMonthAsLines(year, i+1, opts, false)
+	}
+	if opts.DayCounts {
+		appendDayCounts(allMonthLines, year)
+	}
+
+	perRow := monthsPerRow(allMonthLines, opts, monthsInQuarter)
+	rows, totalWidth := layoutMonths(allMonthLines, perRow)
+	if opts.Width > 0 {
+		totalWidth = opts.Width
 	}
 
-	// Calculate total width for centering the year
-	totalWidth := 0
-	for quarter := 0; quarter < quartersInYear; quarter++ {
-		quarterWidth := calculateQuarterWidth(allMonthLines, quarter)
-		if quarterWidth > totalWidth {
-			totalWidth = quarterWidth
+	// Align and print the year
+	header := strconv.Itoa(year)
+	if opts.ShowGregorian {
+		header += " " + gregorianYears(year)
+	}
+	yearStr := alignText(header, totalWidth, opts.HeaderAlign)
+	out := &strings.Builder{}
+	fmt.Fprintf(out, "%s\n\n", paint(opts.theme().Header, yearStr))
+
+	// Display each row of months
+	for i, row := range rows {
+		first := i * perRow
+		last := min(first+perRow, monthsInYear) - 1
+
+		// Label rows in which a season starts
+		if opts.QuarterLabels && (first%monthsInQuarter == 0 || first/monthsInQuarter != last/monthsInQuarter) {
+			label := alignText(seasonLabel(first, last, year, opts.Locale), totalWidth, AlignCenter)
+			fmt.Fprintf(out, "%s\n", paint(opts.theme().Header, label))
 		}
+
+		out.WriteString(strings.Join(row, "\n") + "\n\n")
+	}
+	return out.String(), totalWidth
+}
+
+// appendDayCounts adds a footer with the number of days under each month. Months
+// are first padded to the same height so the footers of a row line up.
+func appendDayCounts(allMonthLines [][]string, year int) {
+	maxLines := 0
+	for _, lines := range allMonthLines {
+		maxLines = max(maxLines, len(lines))
+	}
+	padMonthLines(allMonthLines, maxLines)
+
+	for i, lines := range allMonthLines {
+		footer := alignText(fmt.Sprintf("%d days", GetDaysInMonth(year, i+1)), calculateTableWidth(lines), AlignCenter)
+		allMonthLines[i] = append(lines, paint(dimColor, footer))
 	}
+}
+
+// DisplayYearTable displays the entire year using colored, aligned tables
+func DisplayYearTable(year int, opts Options) error {
+	return writeOutput(opts, RenderYear(year, opts))
+}
+
+// RenderYear returns the entire year, with its header and every month, as a string
+func RenderYear(year int, opts Options) string {
+	// Resolve today once so every month highlights the same date
+	opts.Today = opts.today()
+
+	yearOutput, _ := renderYear(year, opts)
+	return yearOutput
+}
+
+// DisplayTwoYearsTable displays two consecutive years stacked under a combined header
+func DisplayTwoYearsTable(year int, opts Options) error {
+	opts.Today = opts.today()
 
-	// Center and print the year
-	yearStr := fmt.Sprintf("%d", year)
-	yearPadding := (totalWidth - len(yearStr)) / 2
-	if yearPadding < 0 {
-		yearPadding = 0
+	firstYear, width := renderYear(year, opts)
+	secondYear, _ := renderYear(year+1, opts)
+
+	header := alignText(fmt.Sprintf("%d - %d", year, year+1), width, opts.HeaderAlign)
+
+	out := &strings.Builder{}
+	fmt.Fprintf(out, "%s\n\n", paint(opts.theme().Header, header))
+	out.WriteString(firstYear)
+	out.WriteString(strings.Repeat("-", width) + "\n\n")
+	out.WriteString(secondYear)
+	return writeOutput(opts, out.String())
+}
+
+// DisplayYearSummary displays one line per month listing its holidays, e.g. "Farvardin: 1,2,3,4,12,13"
+func DisplayYearSummary(year int, opts Options) error {
+	out := &strings.Builder{}
+	for month := 1; month <= monthsInYear; month++ {
+		days := MonthHolidays(year, month)
+		dayStrs := make([]string, len(days))
+		for i, day := range days {
+			dayStrs[i] = strconv.Itoa(day)
+		}
+		line := fmt.Sprintf("%s: %s", opts.Locale.MonthName(month), strings.Join(dayStrs, ","))
+		out.WriteString(strings.TrimRight(line, " ") + "\n")
 	}
-	fmt.Printf("%s%s%s%s\n\n", strings.Repeat(" ", yearPadding), headerColor, yearStr, resetColor)
+	return writeOutput(opts, out.String())
+}
 
-	// Display each quarter
-	for quarter := 0; quarter < quartersInYear; quarter++ {
-		// Get three months for this quarter
-		monthLines := make([][]string, monthsInQuarter)
-		for i := 0; i < monthsInQuarter; i++ {
-			monthIdx := quarter*monthsInQuarter + i
-			monthLines[i] = allMonthLines[monthIdx]
+// DisplayGregorianMonth lists each day of a Gregorian month next to its Jalali
+// date. With opts.MarkMonthStart a separator names each Jalali month where it begins.
+func DisplayGregorianMonth(gy, gm int, opts Options) error {
+	if gm < 1 || gm > monthsInYear {
+		return fmt.Errorf("month %d: %w", gm, ErrOutOfRange)
+	}
+	for _, gd := range []int{1, gregorianDaysInMonth(gy, gm)} {
+		if err := ValidateGregorian(gy, gm, gd); err != nil {
+			return err
 		}
+	}
 
-		// Pad months to same height and ensure consistent width
-		padMonthLines(monthLines, maxLines)
+	theme := opts.theme()
+	header := []string{paint(theme.Weekday, "Gregorian"), paint(theme.Weekday, "Jalali")}
 
-		// Print side by side with consistent spacing
-		for line := 0; line < maxLines; line++ {
-			fmt.Printf("%s  %s  %s\n", monthLines[0][line], monthLines[1][line], monthLines[2][line])
+	var rows [][]string
+	today := opts.today()
+	for i, date := range GregorianMonthDays(gy, gm) {
+		gregorian := time.Date(gy, time.Month(gm), i+1, 0, 0, 0, 0, time.UTC)
+		jalali := fmt.Sprintf("%2d %s %d", date.Day, opts.Locale.MonthName(date.Month), date.Year)
+		if !opts.NoToday && date == today {
+			jalali = paint(theme.Today, jalali)
 		}
-		fmt.Println()
+		if opts.MarkMonthStart && date.Day == 1 {
+			label := fmt.Sprintf("-- %s %d --", opts.Locale.MonthName(date.Month), date.Year)
+			rows = append(rows, []string{"", paint(theme.Header, label)})
+		}
+		rows = append(rows, []string{gregorian.Format("Mon 2006-01-02"), jalali})
 	}
+	lines := formatGrid(header, rows, AlignLeft)
+
+	title := alignText(fmt.Sprintf("%s %d", time.Month(gm), gy), calculateTableWidth(lines), opts.HeaderAlign)
+	return writeOutput(opts, paint(theme.Header, title)+"\n"+strings.Join(lines, "\n")+"\n")
 }
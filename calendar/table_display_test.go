@@ -0,0 +1,320 @@
+package calendar
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestRenderMonthStartDay(t *testing.T) {
+	// Mordad has 31 days, so late starting weekdays need a sixth week row
+	for day := 0; day < daysInWeek; day++ {
+		t.Run(fmt.Sprint(day), func(t *testing.T) {
+			opts := plain
+			opts.StartDay = &day
+			assertGolden(t, fmt.Sprintf("start_day_%d", day), RenderMonth(1403, 5, opts))
+		})
+	}
+}
+
+func TestTruncateANSI(t *testing.T) {
+	const cyan = "\033[1;36m"
+	mordad := cyan + "مرداد ۱۴۰۳" + resetColor
+	tests := []struct {
+		name  string
+		in    string
+		width int
+		want  string
+	}{
+		{"persian cut in a word", mordad, 3, cyan + "مرد" + resetColor},
+		{"persian cut at the space", mordad, 6, cyan + "مرداد " + resetColor},
+		{"persian kept whole", mordad, 20, mordad},
+		{"zero width", mordad, 0, cyan + resetColor},
+		{"cut in an unterminated escape", cyan + "مرداد\033[3", 10, cyan + "مرداد" + resetColor},
+		{"cut before the next color", cyan + "ab\033[31mcd" + resetColor, 2, cyan + "ab\033[31m" + resetColor},
+		{"cut in the middle of a rune", cyan + "مرداد"[:5], 10, cyan + "مر" + resetColor},
+		{"invalid byte inside", cyan + "مر\xd8داد" + resetColor, 4, cyan + "مردا" + resetColor},
+		{"zero-width non-joiner", cyan + "سه‌شنبه" + resetColor, 3, cyan + "سه‌ش" + resetColor},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := truncateANSI(tt.in, tt.width)
+			if got != tt.want {
+				t.Errorf("truncateANSI(%q, %d) = %q, want %q", tt.in, tt.width, got, tt.want)
+			}
+			if !utf8.ValidString(got) {
+				t.Errorf("truncateANSI(%q, %d) = %q is not valid UTF-8", tt.in, tt.width, got)
+			}
+			if w := visibleWidth(got); w > tt.width || w != min(tt.width, visibleWidth(strings.ToValidUTF8(tt.in, ""))) {
+				t.Errorf("truncateANSI(%q, %d) is %d columns wide", tt.in, tt.width, w)
+			}
+			if !strings.HasSuffix(got, resetColor) {
+				t.Errorf("truncateANSI(%q, %d) = %q does not end with a reset", tt.in, tt.width, got)
+			}
+		})
+	}
+}
+
+func TestShiftMonth(t *testing.T) {
+	tests := []struct {
+		year, month, delta  int
+		wantYear, wantMonth int
+	}{
+		{1403, 5, 0, 1403, 5},
+		{1403, 12, 1, 1404, 1},
+		{1404, 1, -1, 1403, 12},
+		{1403, 10, 6, 1404, 4},
+		{1403, 3, -15, 1401, 12},
+		{1403, 1, 24, 1405, 1},
+	}
+	for _, tt := range tests {
+		year, month := ShiftMonth(tt.year, tt.month, tt.delta)
+		if year != tt.wantYear || month != tt.wantMonth {
+			t.Errorf("ShiftMonth(%d, %d, %d) = %d-%02d, want %d-%02d", tt.year, tt.month, tt.delta, year, month, tt.wantYear, tt.wantMonth)
+		}
+	}
+}
+
+func TestRenderMonthNoToday(t *testing.T) {
+	today := "\x1b[" + DefaultTheme.Today + "m"
+	opts := Options{Today: JalaliDate{Year: 1403, Month: 5, Day: 12}}
+
+	if got := RenderMonth(1403, 5, opts); !strings.Contains(got, today) {
+		t.Fatalf("today is not highlighted:\n%q", got)
+	}
+	opts.NoToday = true
+	if got := RenderMonth(1403, 5, opts); strings.Contains(got, today) {
+		t.Errorf("today is highlighted despite NoToday:\n%q", got)
+	}
+}
+
+func TestDisplayMonthSpan(t *testing.T) {
+	tests := []struct {
+		name               string
+		year, month        int
+		first, last        int
+		wantFirst, wantEnd string
+	}{
+		// Forward from Esfand across Nowruz into the next year
+		{"span_nowruz_forward", 1403, 12, 0, 2, "Esfand 1403", "Ordibehesht 1404"},
+		// Back from Farvardin across Nowruz into the previous year
+		{"span_nowruz_backward", 1404, 1, -2, 0, "Bahman 1403", "Farvardin 1404"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			opts := plain
+			opts.Output = &buf
+			if err := DisplayMonthSpan(tt.year, tt.month, tt.first, tt.last, opts); err != nil {
+				t.Fatal(err)
+			}
+			got := buf.String()
+			first, end := strings.Index(got, tt.wantFirst), strings.Index(got, tt.wantEnd)
+			if first < 0 || end < 0 || first > end {
+				t.Errorf("span does not run from %s to %s:\n%s", tt.wantFirst, tt.wantEnd, got)
+			}
+			assertGolden(t, tt.name, got)
+
+			// The span is the same months as displaying them ahead from its first one
+			var ahead bytes.Buffer
+			opts.Output = &ahead
+			startYear, startMonth := ShiftMonth(tt.year, tt.month, tt.first)
+			if err := DisplayMonthsAhead(startYear, startMonth, tt.last-tt.first+1, opts); err != nil {
+				t.Fatal(err)
+			}
+			if ahead.String() != got {
+				t.Errorf("span differs from the months ahead of %d-%02d:\n%s\nwant:\n%s", startYear, startMonth, got, ahead.String())
+			}
+		})
+	}
+
+	t.Run("start after end", func(t *testing.T) {
+		var buf bytes.Buffer
+		opts := plain
+		opts.Output = &buf
+		if err := DisplayMonthSpan(1403, 5, 2, -2, opts); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("DisplayMonthSpan(1403, 5, 2, -2) = %v, want %v", err, ErrOutOfRange)
+		}
+		if buf.Len() != 0 {
+			t.Errorf("a reversed span printed %q", buf.String())
+		}
+	})
+}
+
+func TestDisplayMonthsAhead(t *testing.T) {
+	var buf bytes.Buffer
+	opts := plain
+	opts.Output = &buf
+	if err := DisplayMonthsAhead(1403, 10, 6, opts); err != nil {
+		t.Fatal(err)
+	}
+	got := buf.String()
+
+	// Six months from Dey run past Esfand into 1404
+	at := -1
+	for _, name := range []string{"Dey 1403", "Bahman 1403", "Esfand 1403", "Farvardin 1404", "Ordibehesht 1404", "Khordad 1404"} {
+		next := strings.Index(got, name)
+		if next <= at {
+			t.Fatalf("%s is missing or out of order:\n%s", name, got)
+		}
+		at = next
+	}
+	if strings.Contains(got, "Tir") {
+		t.Errorf("more than six months displayed:\n%s", got)
+	}
+	assertGolden(t, "ahead_dey", got)
+
+	for _, count := range []int{0, -1} {
+		if err := DisplayMonthsAhead(1403, 10, count, opts); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("DisplayMonthsAhead(1403, 10, %d) = %v, want %v", count, err, ErrOutOfRange)
+		}
+	}
+}
+
+func TestNoPadMonth(t *testing.T) {
+	render := func(display func(Options) error, opts Options) string {
+		t.Helper()
+		var buf bytes.Buffer
+		opts.Output = &buf
+		if err := display(opts); err != nil {
+			t.Fatal(err)
+		}
+		return buf.String()
+	}
+	// Farvardin 1404 needs six week rows and FixedHeight pads shorter months with blank ones
+	month := func(opts Options) error { return DisplayMonthTable(1404, 1, opts) }
+	short := func(opts Options) error { return DisplayMonthTable(1403, 5, opts) }
+	three := func(opts Options) error { return DisplayThreeMonthsTable(1404, 1, opts) }
+
+	for _, tt := range []struct {
+		name    string
+		display func(Options) error
+		opts    Options
+	}{
+		{"month", month, plain},
+		{"fixed height", short, Options{NoToday: true, Theme: &Theme{}, FixedHeight: true}},
+		{"holidays", month, Options{NoToday: true, Theme: &Theme{}, Holidays: true}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			padded := render(tt.display, tt.opts)
+			tt.opts.NoPadMonth = true
+			got := render(tt.display, tt.opts)
+			if got == padded {
+				t.Fatalf("nothing was trimmed from %q", padded)
+			}
+			if !strings.HasSuffix(got, "\n") || strings.HasSuffix(got, "\n\n") {
+				t.Errorf("output does not end in a single newline: %q", got)
+			}
+			for _, line := range strings.Split(got, "\n") {
+				if strings.HasSuffix(line, " ") {
+					t.Errorf("line %q ends in a space", line)
+				}
+			}
+			if got != trimTrailingSpace(padded) {
+				t.Errorf("output is not the padded month trimmed:\n%s\nwant:\n%s", got, trimTrailingSpace(padded))
+			}
+		})
+	}
+
+	t.Run("three months", func(t *testing.T) {
+		padded := render(three, plain)
+		opts := plain
+		opts.NoPadMonth = true
+		if got := render(three, opts); got != padded {
+			t.Errorf("NoPadMonth changed a multi-month view:\n%s\nwant:\n%s", got, padded)
+		}
+		// The months side by side still line up, so every line keeps its full width
+		lines := strings.Split(strings.TrimSuffix(padded, "\n"), "\n")
+		for _, line := range lines[1:] {
+			if utf8.RuneCountInString(line) != utf8.RuneCountInString(lines[0]) {
+				t.Errorf("line %q is not as wide as %q", line, lines[0])
+			}
+		}
+	})
+}
+
+func TestTrimTrailingSpace(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"a  \nb \n", "a\nb\n"},
+		{"a\n  \n\n", "a\n"},
+		{"  a b  ", "  a b\n"},
+		{"", "\n"},
+	}
+	for _, tt := range tests {
+		if got := trimTrailingSpace(tt.in); got != tt.want {
+			t.Errorf("trimTrailingSpace(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestDisplayGregorianMonthMarkMonthStart(t *testing.T) {
+	var buf bytes.Buffer
+	opts := plain
+	opts.Output = &buf
+	opts.MarkMonthStart = true
+	if err := DisplayGregorianMonth(2024, 7, opts); err != nil {
+		t.Fatal(err)
+	}
+	got := buf.String()
+	assertGolden(t, "gregorian_mark_month_start", got)
+
+	// 1 Mordad 1403 is 22 July 2024, and Tir begins in June so July has one separator
+	lines := strings.Split(got, "\n")
+	marks := 0
+	for i, line := range lines {
+		if !strings.Contains(line, "--") {
+			continue
+		}
+		marks++
+		if !strings.Contains(line, "-- Mordad 1403 --") || i+1 == len(lines) || !strings.Contains(lines[i+1], "2024-07-22") {
+			t.Errorf("separator %q is not above 2024-07-22", line)
+		}
+	}
+	if marks != 1 {
+		t.Errorf("%d separators, want 1:\n%s", marks, got)
+	}
+}
+
+func TestHolidayLegendGregorianDate(t *testing.T) {
+	opts := plain
+	opts.Holidays = true
+	got := RenderMonth(1403, 1, opts)
+
+	// Nowruz 1403 fell on 20 March 2024 and Sizdah Bedar on 1 April, in the next Gregorian month
+	for _, want := range []string{"\n   1  Nowruz (2024-03-20)\n", "\n  13  Sizdah Bedar (2024-04-01)\n"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("legend has no line %q:\n%s", strings.Trim(want, "\n"), got)
+		}
+	}
+
+	// The colored day number leaves the date that follows it intact
+	opts.Theme = nil
+	if got := RenderMonth(1403, 1, opts); !strings.Contains(got, "Nowruz (2024-03-20)") {
+		t.Errorf("colored legend has no Gregorian date for Nowruz:\n%q", got)
+	}
+}
+
+func TestHolidayLegendLocale(t *testing.T) {
+	for _, tt := range []struct {
+		golden string
+		locale Locale
+		name   string
+	}{
+		{"legend_persian", LocalePersian, "نوروز"},
+		{"legend_english", LocaleEnglish, "Nowruz"},
+	} {
+		t.Run(tt.golden, func(t *testing.T) {
+			opts := plain
+			opts.Locale = tt.locale
+			// Farvardin and Khordad 1403 hold the solar holidays of the spring
+			got := renderHolidayLegend(1403, 1, opts) + renderHolidayLegend(1403, 3, opts)
+			if !strings.Contains(got, "   1  "+tt.name+" (2024-03-20)\n") {
+				t.Errorf("legend does not name Nowruz %s:\n%s", tt.name, got)
+			}
+			assertGolden(t, tt.golden, got)
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package calendar
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Theme holds the ANSI SGR parameters (such as "1;33" for bold yellow) used
+// to style each element of the calendar. An empty value leaves the element unstyled.
+type Theme struct {
+	Header    string // month and year headers
+	Weekday   string // weekday names above the days
+	Today     string // today's date
+	Highlight string // highlighted dates and ranges
+	Holiday   string // official holidays
+	Weekend   string // weekend days, Jome unless Options.Weekend says otherwise
+	Event     string // days with a user defined event
+	WeekStart string // days in the first column, with Options.FirstDayHighlight
+}
+
+// DefaultTheme is the theme used when Options.Theme is nil
+var DefaultTheme = Theme{
+	Header:    "1;36", // bold cyan
+	Weekday:   "97;1", // bold bright white
+	Today:     "1;33", // bold yellow
+	Highlight: "7",    // reverse video
+	Holiday:   "1;31", // bold red
+	Event:     "4",    // underline
+	WeekStart: "1",    // bold
+}
+
+// LightTheme is tuned for terminals with a light background: dark text and
+// background tints instead of the bright colors of DefaultTheme
+var LightTheme = Theme{
+	Header:    "1;34",   // bold blue
+	Weekday:   "1;30",   // bold black
+	Today:     "30;103", // black on bright yellow
+	Highlight: "47",     // light gray background
+	Holiday:   "31",     // red
+	Event:     "4",      // underline
+	WeekStart: "1",      // bold
+}
+
+// colorCodes maps color names to their SGR foreground codes
+var colorCodes = map[string]string{
+	"black":   "30",
+	"red":     "31",
+	"green":   "32",
+	"yellow":  "33",
+	"blue":    "34",
+	"magenta": "35",
+	"cyan":    "36",
+	"white":   "37",
+}
+
+// styleCodes maps text style names to their SGR codes
+var styleCodes = map[string]string{
+	"bold":      "1",
+	"underline": "4",
+	"reverse":   "7",
+}
+
+// TodayCode returns the SGR code for today's date from a style (bold, underline
+// or reverse) and a color name (black, red, green, yellow, blue, magenta, cyan
+// or white), or a "#RRGGBB" hex color, which Theme.ForDepth brings down to what
+// the terminal can show. An empty style or color keeps that part of the default
+// bold yellow.
+func TodayCode(style, color string) (string, error) {
+	if style == "" {
+		style = "bold"
+	}
+	if color == "" {
+		color = "yellow"
+	}
+
+	styleCode, ok := styleCodes[style]
+	if !ok {
+		return "", fmt.Errorf("unknown style %q (valid: bold, underline, reverse)", style)
+	}
+	if strings.HasPrefix(color, "#") {
+		colorCode, err := ParseHexColor(color)
+		if err != nil {
+			return "", err
+		}
+		return joinCodes(styleCode, colorCode), nil
+	}
+	colorCode, ok := colorCodes[color]
+	if !ok {
+		return "", fmt.Errorf("unknown color %q (valid: black, red, green, yellow, blue, magenta, cyan, white or #RRGGBB)", color)
+	}
+	return joinCodes(styleCode, colorCode), nil
+}
+
+// themeElements maps the element names used in theme files to their Theme fields
+func themeElements(t *Theme) map[string]*string {
+	return map[string]*string{
+		"header":     &t.Header,
+		"weekday":    &t.Weekday,
+		"today":      &t.Today,
+		"highlight":  &t.Highlight,
+		"holiday":    &t.Holiday,
+		"weekend":    &t.Weekend,
+		"event":      &t.Event,
+		"week-start": &t.WeekStart,
+	}
+}
+
+// LoadTheme reads a theme file made of "element = code" lines, where code is an
+// ANSI SGR parameter list such as "1;33". Blank lines and lines starting with '#'
+// are ignored. Elements missing from the file keep their DefaultTheme value.
+func LoadTheme(r io.Reader) (Theme, error) {
+	theme := DefaultTheme
+	elements := themeElements(&theme)
+
+	scanner := bufio.NewScanner(r)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		name, code, found := strings.Cut(line, "=")
+		if !found {
+			return Theme{}, fmt.Errorf("line %d: expected element = code", lineNo)
+		}
+		name, code = strings.TrimSpace(name), strings.TrimSpace(code)
+
+		field, ok := elements[name]
+		if !ok {
+			return Theme{}, fmt.Errorf("line %d: unknown element %q", lineNo, name)
+		}
+		if !isSGRCode(code) {
+			return Theme{}, fmt.Errorf("line %d: invalid code %q for %s", lineNo, code, name)
+		}
+		*field = code
+	}
+
+	if err := scanner.Err(); err != nil {
+		return Theme{}, err
+	}
+	return theme, nil
+}
+
+// isSGRCode reports whether code is a list of numeric SGR parameters separated by ';'
+func isSGRCode(code string) bool {
+	for _, param := range strings.Split(code, ";") {
+		if param == "" && code != "" {
+			return false
+		}
+		for _, r := range param {
+			if r < '0' || r > '9' {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// paint wraps text in the escape sequence for an SGR code
+func paint(code, text string) string {
+	if code == "" {
+		return text
+	}
+	return "\033[" + code + "m" + text + resetColor
+}
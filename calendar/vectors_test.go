@@ -0,0 +1,126 @@
+package calendar
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// readVectors returns the fields of each line of testdata/name, skipping blank
+// lines and comments
+func readVectors(t *testing.T, name string) [][]string {
+	t.Helper()
+	f, err := os.Open("testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var rows [][]string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		rows = append(rows, strings.Fields(line))
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return rows
+}
+
+func TestConversionVectors(t *testing.T) {
+	for _, row := range readVectors(t, "conversions.txt") {
+		jalali, gregorian := row[0], row[1]
+		t.Run(jalali, func(t *testing.T) {
+			jy, jm, jd, err := splitDate(jalali)
+			if err != nil {
+				t.Fatal(err)
+			}
+			gy, gm, gd, err := splitDate(gregorian)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := JalaliDate{Year: jy, Month: jm, Day: jd}
+			if got := GregorianToJalali(gy, gm, gd); got != want {
+				t.Errorf("GregorianToJalali(%s) = %v, want %v", gregorian, got, want)
+			}
+			if y, m, d := JalaliToGregorian(jy, jm, jd); y != gy || m != gm || d != gd {
+				t.Errorf("JalaliToGregorian(%s) = %04d-%02d-%02d, want %s", jalali, y, m, d, gregorian)
+			}
+			if d, err := ParseJalali(jalali); d != want || err != nil {
+				t.Errorf("ParseJalali(%s) = %v, %v", jalali, d, err)
+			}
+			if err := ValidateGregorian(gy, gm, gd); err != nil {
+				t.Errorf("ValidateGregorian(%s): %v", gregorian, err)
+			}
+		})
+	}
+}
+
+func TestLeapYearVectors(t *testing.T) {
+	for _, row := range readVectors(t, "leap_years.txt") {
+		year, err := strconv.Atoi(row[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		leap, err := strconv.ParseBool(row[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := IsJalaliLeapYear(year); got != leap {
+			t.Errorf("IsJalaliLeapYear(%d) = %v, want %v", year, got, leap)
+		}
+		esfand := 29
+		if leap {
+			esfand = 30
+		}
+		if got, _ := DaysInMonth(year, 12); got != esfand {
+			t.Errorf("DaysInMonth(%d, 12) = %d, want %d", year, got, esfand)
+		}
+		// Esfand 30 exists exactly in leap years, and Nowruz follows the last day
+		if _, err := ParseJalali(fmt.Sprintf("%d-12-30", year)); (err == nil) != leap {
+			t.Errorf("ParseJalali(%d-12-30) error %v, leap year %v", year, err, leap)
+		}
+		if next := LastDayOfYear(year).AddDays(1); next != FirstDayOfYear(year+1) {
+			t.Errorf("the day after the last day of %d is %v", year, next)
+		}
+	}
+}
+
+func TestGregorianOutsideSupportedYears(t *testing.T) {
+	first := JalaliToJDN(FirstDayOfYear(MinYear))
+	last := JalaliToJDN(LastDayOfYear(MaxYear))
+
+	tests := []struct {
+		jdn     int
+		inRange bool
+	}{
+		{first - 1, false},
+		{first, true},
+		{last, true},
+		{last + 1, false},
+	}
+	for _, tt := range tests {
+		gy, gm, gd := jdnToGregorian(tt.jdn)
+		err := ValidateGregorian(gy, gm, gd)
+		if tt.inRange && err != nil {
+			t.Errorf("ValidateGregorian(%d-%02d-%02d): %v", gy, gm, gd, err)
+		}
+		if !tt.inRange && !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("ValidateGregorian(%d-%02d-%02d) = %v, want ErrOutOfRange", gy, gm, gd, err)
+		}
+	}
+
+	if err := DisplayGregorianMonth(5000, 1, Options{}); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("DisplayGregorianMonth(5000, 1) = %v, want ErrOutOfRange", err)
+	}
+}
@@ -0,0 +1,30 @@
+package calendar
+
+// Weekend is the set of weekdays (0=Shanbe ... 6=Jome) that are days off.
+// A nil Weekend is IranianWeekend.
+type Weekend []int
+
+var (
+	// IranianWeekend is the weekend in Iran: Jome (Friday)
+	IranianWeekend = Weekend{6}
+	// DiasporaWeekend is the Saturday and Sunday weekend: Shanbe and Yekshanbe
+	DiasporaWeekend = Weekend{0, 1}
+)
+
+// IsWeekend reports whether a date falls on the weekend; a nil weekend means IranianWeekend
+func IsWeekend(d JalaliDate, weekend Weekend) bool {
+	return weekend.contains(GetDayOfWeek(d.Year, d.Month, d.Day))
+}
+
+// contains reports whether a weekday (0=Shanbe ... 6=Jome) is part of the weekend
+func (w Weekend) contains(weekday int) bool {
+	if w == nil {
+		w = IranianWeekend
+	}
+	for _, day := range w {
+		if day == weekday {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+package calendar
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsWeekend(t *testing.T) {
+	tests := []struct {
+		date              JalaliDate
+		iranian, diaspora bool
+	}{
+		// Nowruz 1404 falls on a Jome
+		{JalaliDate{Year: 1404, Month: 1, Day: 1}, true, false},
+		{JalaliDate{Year: 1404, Month: 1, Day: 2}, false, true},
+		{JalaliDate{Year: 1404, Month: 1, Day: 3}, false, true},
+		{JalaliDate{Year: 1404, Month: 1, Day: 4}, false, false},
+		{JalaliDate{Year: 1404, Month: 1, Day: 7}, false, false},
+		{JalaliDate{Year: 1404, Month: 1, Day: 8}, true, false},
+	}
+	for _, tt := range tests {
+		if got := IsWeekend(tt.date, IranianWeekend); got != tt.iranian {
+			t.Errorf("IsWeekend(%v, IranianWeekend) = %v, want %v", tt.date, got, tt.iranian)
+		}
+		if got := IsWeekend(tt.date, nil); got != tt.iranian {
+			t.Errorf("IsWeekend(%v, nil) = %v, want the Iranian weekend's %v", tt.date, got, tt.iranian)
+		}
+		if got := IsWeekend(tt.date, DiasporaWeekend); got != tt.diaspora {
+			t.Errorf("IsWeekend(%v, DiasporaWeekend) = %v, want %v", tt.date, got, tt.diaspora)
+		}
+	}
+}
+
+func TestMonthStatsWeekend(t *testing.T) {
+	tests := []struct {
+		year, month int
+		weekend     Weekend
+		want        MonthStats
+	}{
+		// Mordad 1403 starts on a Doshanbe and has no holidays
+		{1403, 5, IranianWeekend, MonthStats{WeekendDays: 4, Holidays: 0, WorkingDays: 27}},
+		{1403, 5, DiasporaWeekend, MonthStats{WeekendDays: 8, Holidays: 0, WorkingDays: 23}},
+		// Farvardin 1404 starts on a Jome; two of its Nowruz holidays fall on Shanbe and Yekshanbe
+		{1404, 1, IranianWeekend, MonthStats{WeekendDays: 5, Holidays: 6, WorkingDays: 21}},
+		{1404, 1, DiasporaWeekend, MonthStats{WeekendDays: 10, Holidays: 6, WorkingDays: 17}},
+		{1404, 1, nil, MonthStats{WeekendDays: 5, Holidays: 6, WorkingDays: 21}},
+	}
+	for _, tt := range tests {
+		if got := GetMonthStatsWithWeekend(tt.year, tt.month, tt.weekend); got != tt.want {
+			t.Errorf("GetMonthStatsWithWeekend(%d, %d, %v) = %+v, want %+v", tt.year, tt.month, tt.weekend, got, tt.want)
+		}
+	}
+	if got := GetMonthStats(1404, 1); got != (MonthStats{WeekendDays: 5, Holidays: 6, WorkingDays: 21}) {
+		t.Errorf("GetMonthStats(1404, 1) = %+v, want the Iranian weekend's counts", got)
+	}
+
+	opts := plain
+	opts.Stats = true
+	opts.Weekend = DiasporaWeekend
+	if out := RenderMonth(1403, 5, opts); !strings.Contains(out, "Weekend days: 8  Holidays: 0  Working days: 23") {
+		t.Errorf("RenderMonth with Stats and DiasporaWeekend lacks its counts:\n%s", out)
+	}
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/alizmhdi/shamsi-calendar/calendar"
+
+	"github.com/spf13/cobra"
+)
+
+// ANSI sequences used to redraw the clock line in place
+const (
+	hideCursor = "\033[?25l"
+	showCursor = "\033[?25h"
+	clearLine  = "\r\033[K"
+)
+
+var clockFormat string
+
+var clockCmd = &cobra.Command{
+	Use:   "clock",
+	Short: "Show the current Jalali date and time, updated every second",
+	Long: `Show the current Jalali date and time on a single line, redrawn every
+second until interrupted with Ctrl+C.
+
+--format accepts the tokens YYYY, YY, MMMM, MM, M, DD, D, dddd (weekday name),
+HH, mm, ss and zz (time zone abbreviation).`,
+	Example: "  scal clock\n  scal clock --format \"YYYY-MM-DD HH:mm:ss\" --tz Asia/Tehran",
+	Args:    exactArgs(0),
+	RunE:    runClock,
+}
+
+func init() {
+	clockCmd.Flags().StringVar(&clockFormat, "format", "dddd D MMMM YYYY  HH:mm:ss", "layout of the clock line")
+	rootCmd.AddCommand(clockCmd)
+}
+
+func runClock(cmd *cobra.Command, args []string) error {
+	locale, err := parseLocale()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(interrupt)
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	out := cmd.OutOrStdout()
+	fmt.Fprint(out, hideCursor)
+	// Restore the cursor and end the line however the clock stops
+	defer fmt.Fprint(out, showCursor+"\n")
+
+	for {
+		if _, err := fmt.Fprint(out, clearLine+calendar.FormatTime(currentTime(), clockFormat, locale)); err != nil {
+			return fmt.Errorf("%w: %w", ErrIO, err)
+		}
+
+		select {
+		case <-interrupt:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
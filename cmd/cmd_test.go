@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alizmhdi/shamsi-calendar/calendar"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// setClock makes the commands run as of now until the test ends
+func setClock(t *testing.T, now time.Time) {
+	t.Helper()
+	saved := clock
+	clock = calendar.FixedClock(now)
+	t.Cleanup(func() { clock = saved })
+}
+
+// execute runs scal with args and returns what it wrote to its output. The
+// flags are reset afterwards so that the next run starts from their defaults.
+func execute(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	out, _, err := executeWithStderr(t, args...)
+	return out, err
+}
+
+// executeWithStderr runs scal like execute and also returns what it wrote to stderr
+func executeWithStderr(t *testing.T, args ...string) (stdout, stderr string, err error) {
+	t.Helper()
+	t.Cleanup(func() { resetFlags(rootCmd) })
+
+	var out, errOut bytes.Buffer
+	rootCmd.SetOut(&out)
+	rootCmd.SetErr(&errOut)
+	rootCmd.SetArgs(args)
+	err = rootCmd.Execute()
+	return out.String(), errOut.String(), err
+}
+
+// resetFlags puts every flag of c and its subcommands that was set back to its default
+func resetFlags(c *cobra.Command) {
+	reset := func(f *pflag.Flag) {
+		if !f.Changed {
+			return
+		}
+		switch v := f.Value.(type) {
+		case pflag.SliceValue:
+			_ = v.Replace(nil)
+		case *calendar.JalaliDateValue:
+			*v = calendar.JalaliDateValue{}
+		case *calendar.JalaliDateListValue:
+			*v = nil
+		case *calendar.DateRangeListValue:
+			*v = nil
+		default:
+			_ = f.Value.Set(f.DefValue)
+		}
+		f.Changed = false
+	}
+	c.Flags().VisitAll(reset)
+	c.PersistentFlags().VisitAll(reset)
+	for _, sub := range c.Commands() {
+		resetFlags(sub)
+	}
+}
+
+// assertGolden compares got with testdata/name.golden; with -update it rewrites the file instead
+func assertGolden(t *testing.T, name, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.MkdirAll("testdata", 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v (run go test -update to create it)", err)
+	}
+	if got != string(want) {
+		t.Errorf("output differs from %s\ngot:\n%s\nwant:\n%s", path, got, want)
+	}
+}
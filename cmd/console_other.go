@@ -0,0 +1,9 @@
+//go:build !windows
+
+package cmd
+
+// enableUTF8Console reports whether the console accepts UTF-8 output.
+// Terminals outside Windows are expected to handle UTF-8 already.
+func enableUTF8Console() bool {
+	return true
+}
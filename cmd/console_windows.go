@@ -0,0 +1,19 @@
+//go:build windows
+
+package cmd
+
+import "syscall"
+
+// utf8CodePage is the Windows code page identifier for UTF-8
+const utf8CodePage = 65001
+
+// enableUTF8Console switches the console output code page to UTF-8 so Persian text renders.
+// It reports false when the code page could not be changed.
+func enableUTF8Console() bool {
+	proc := syscall.NewLazyDLL("kernel32.dll").NewProc("SetConsoleOutputCP")
+	if proc.Find() != nil {
+		return false
+	}
+	ok, _, _ := proc.Call(utf8CodePage)
+	return ok != 0
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/alizmhdi/shamsi-calendar/calendar"
+
+	"github.com/spf13/cobra"
+)
+
+var convertFrom string
+
+var convertCmd = &cobra.Command{
+	Use:   "convert DATE",
+	Short: "Convert a date between the Gregorian and Jalali calendars",
+	Long: `Convert a date written as YYYY-MM-DD (or YYYY/MM/DD) between calendars.
+
+By default DATE is read as a Gregorian date and printed as a Jalali date.
+Use --from jalali to convert the other way. A time of day after the date, as in
+"2024-08-02 14:30", is carried through.`,
+	Args: exactArgs(1),
+	RunE: runConvert,
+}
+
+func init() {
+	convertCmd.Flags().StringVar(&convertFrom, "from", "gregorian", "calendar of the given date: gregorian or jalali")
+	rootCmd.AddCommand(convertCmd)
+}
+
+func runConvert(cmd *cobra.Command, args []string) error {
+	var converted string
+	switch convertFrom {
+	case "gregorian":
+		t, precision, err := calendar.ParseGregorianTime(args[0], location)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrValidation, err)
+		}
+		layout, err := outputLayout(cmd, "", precision)
+		if err != nil {
+			return err
+		}
+		converted = calendar.TimeToJalaliString(t, layout)
+	case "jalali":
+		t, precision, err := calendar.ParseJalaliTime(args[0], location)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrValidation, err)
+		}
+		layout, err := outputLayout(cmd, "", precision)
+		if err != nil {
+			return err
+		}
+		converted = calendar.FormatGregorian(t, layout)
+	default:
+		return fmt.Errorf("%w: --from must be gregorian or jalali", ErrValidation)
+	}
+
+	if _, err := fmt.Fprintln(cmd.OutOrStdout(), converted); err != nil {
+		return fmt.Errorf("%w: %w", ErrIO, err)
+	}
+	return nil
+}
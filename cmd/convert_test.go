@@ -0,0 +1,37 @@
+package cmd
+
+import "testing"
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+		code int
+	}{
+		{args: []string{"2024-08-02"}, want: "1403-05-12\n"},
+		{args: []string{"2025/03/20"}, want: "1403-12-30\n"},
+		{args: []string{"1403-05-12", "--from", "jalali"}, want: "2024-08-02\n"},
+		{args: []string{"1403-12-30", "--from", "jalali"}, want: "2025-03-20\n"},
+		{args: []string{"1402-12-30", "--from", "jalali"}, code: ExitOutOfRange},
+		{args: []string{"2023-02-29"}, code: ExitOutOfRange},
+		{args: []string{"1403-05"}, code: ExitValidation},
+		{args: []string{"1403-05-12", "--from", "hijri"}, code: ExitValidation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args[0], func(t *testing.T) {
+			out, err := execute(t, append([]string{"convert", "--utc"}, tt.args...)...)
+			if tt.code != 0 {
+				if code := ExitCode(err); err == nil || code != tt.code {
+					t.Errorf("error %v, exit code %d, want %d", err, code, tt.code)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out != tt.want {
+				t.Errorf("convert %v printed %q, want %q", tt.args, out, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/alizmhdi/shamsi-calendar/calendar"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	diffIn       string
+	diffRelative bool
+)
+
+var diffCmd = &cobra.Command{
+	Use:   "diff FROM [TO]",
+	Short: "Show the time between two Jalali dates",
+	Long: `Show the time from FROM to TO (default: today) as years, months and days,
+followed by the total number of days. The result is negative when TO is before FROM.
+
+--in prints only the total in a single unit. Weeks are the days divided by 7.
+Months count whole calendar months, plus the leftover days divided by the length
+of the month they start in; years are those months divided by 12.
+
+--relative describes FROM as seen from TO in words instead, such as "2 months
+ago" or "in 3 days", in the --locale language.`,
+	Example: "  scal diff 1403-01-01 1403-05-12\n  scal diff 1403-01-01 --in weeks\n  scal diff 1403-12-29 --relative",
+	Args:    rangeArgs(1, 2),
+	RunE:    runDiff,
+}
+
+func init() {
+	diffCmd.Flags().StringVar(&diffIn, "in", "", "print only the total in one unit: days, weeks, months or years")
+	diffCmd.Flags().BoolVar(&diffRelative, "relative", false, "describe FROM relative to TO in words, e.g. 2 months ago")
+	rootCmd.AddCommand(diffCmd)
+}
+
+func runDiff(cmd *cobra.Command, args []string) error {
+	from, err := calendar.ParseJalali(args[0])
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+	to := getCurrentJalaliDate()
+	if len(args) == 2 {
+		if to, err = calendar.ParseJalali(args[1]); err != nil {
+			return fmt.Errorf("%w: %w", ErrValidation, err)
+		}
+	}
+
+	if diffRelative && diffIn != "" {
+		return fmt.Errorf("%w: --relative and --in cannot be used together", ErrValidation)
+	}
+	locale, err := parseLocale()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+
+	var result string
+	switch {
+	case diffRelative:
+		result = calendar.HumanizeInterval(calendar.DaysBetween(to, from), locale)
+	case diffIn == "":
+		years, months, days := to.Sub(from)
+		result = fmt.Sprintf("%s, %s, %s (%s)",
+			plural(years, "year"), plural(months, "month"), plural(days, "day"),
+			plural(calendar.DaysBetween(from, to), "day"))
+	case diffIn == "days":
+		result = strconv.Itoa(calendar.DaysBetween(from, to))
+	case diffIn == "weeks":
+		result = formatAmount(float64(calendar.DaysBetween(from, to)) / 7)
+	case diffIn == "months":
+		result = formatAmount(calendar.MonthsBetween(from, to))
+	case diffIn == "years":
+		result = formatAmount(calendar.MonthsBetween(from, to) / 12)
+	default:
+		return fmt.Errorf("%w: --in must be days, weeks, months or years", ErrValidation)
+	}
+
+	if _, err := fmt.Fprintln(cmd.OutOrStdout(), result); err != nil {
+		return fmt.Errorf("%w: %w", ErrIO, err)
+	}
+	return nil
+}
+
+// plural writes a count with its unit, e.g. "1 day" or "3 days"
+func plural(n int, unit string) string {
+	if n == 1 || n == -1 {
+		return fmt.Sprintf("%d %s", n, unit)
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
+
+// formatAmount writes a fractional total with at most two decimals, e.g. "2.5"
+func formatAmount(v float64) string {
+	s := strconv.FormatFloat(v, 'f', 2, 64)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import "testing"
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"1403-01-01", "1403-05-12"}, "0 years, 4 months, 11 days (135 days)\n"},
+		{[]string{"1403-01-01", "1403-05-12", "--in", "days"}, "135\n"},
+		{[]string{"1403-01-01", "1403-05-12", "--in", "weeks"}, "19.29\n"},
+		{[]string{"1403-01-01", "1403-05-12", "--in", "months"}, "4.35\n"},
+		{[]string{"1403-01-01", "1403-05-12", "--in", "years"}, "0.36\n"},
+		{[]string{"1403-05-12", "1403-01-01", "--in", "months"}, "-4.35\n"},
+		{[]string{"1403-05-12", "--relative"}, "today\n"},
+		{[]string{"1403-05-13", "--relative"}, "tomorrow\n"},
+		{[]string{"1403-03-01", "--relative"}, "2 months ago\n"},
+		{[]string{"1403-05-26", "--relative", "--locale", "fa"}, "2 هفته دیگر\n"},
+		{[]string{"1402-05-12", "1403-05-12", "--relative"}, "1 year ago\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args[0], func(t *testing.T) {
+			setClock(t, pinnedNow)
+			out, err := execute(t, append([]string{"diff", "--utc"}, tt.args...)...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out != tt.want {
+				t.Errorf("diff %v printed %q, want %q", tt.args, out, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffInvalid(t *testing.T) {
+	tests := [][]string{
+		{"1403-01-01", "1403-05-12", "--in", "hours"},
+		{"1403-01-01", "--relative", "--in", "days"},
+		{"1403-13-01"},
+	}
+	for _, args := range tests {
+		t.Run(args[len(args)-1], func(t *testing.T) {
+			if _, err := execute(t, append([]string{"diff"}, args...)...); err == nil {
+				t.Errorf("diff %v succeeded, want an error", args)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/alizmhdi/shamsi-calendar/calendar"
+)
+
+// Exit codes reported to the calling shell
+const (
+	ExitFailure    = 1
+	ExitValidation = 2
+	ExitOutOfRange = 3
+	ExitIO         = 4
+)
+
+// ExitCode maps an error returned by Execute to the exit code for its failure
+// type. A date out of range is reported as such even when it came from invalid
+// input, so ErrOutOfRange is checked before ErrValidation.
+func ExitCode(err error) int {
+	switch {
+	case errors.Is(err, calendar.ErrOutOfRange):
+		return ExitOutOfRange
+	case errors.Is(err, ErrValidation):
+		return ExitValidation
+	case errors.Is(err, ErrIO):
+		return ExitIO
+	default:
+		return ExitFailure
+	}
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/alizmhdi/shamsi-calendar/calendar"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"plain error", errors.New("boom"), ExitFailure},
+		{"validation", fmt.Errorf("%w: unknown flag", ErrValidation), ExitValidation},
+		{"out of range", fmt.Errorf("month 13: %w", calendar.ErrOutOfRange), ExitOutOfRange},
+		{"out of range input", fmt.Errorf("%w: %w", ErrValidation, fmt.Errorf("month 13: %w", calendar.ErrOutOfRange)), ExitOutOfRange},
+		{"i/o", fmt.Errorf("%w: %w", ErrIO, errors.New("broken pipe")), ExitIO},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExitCode(tt.err); got != tt.want {
+				t.Errorf("ExitCode(%v) = %d, want %d", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommandExitCodes(t *testing.T) {
+	tests := []struct {
+		args []string
+		want int
+	}{
+		{[]string{"search", "--holiday", "no such holiday", "-y", "1403"}, ExitFailure},
+		{[]string{"--no-such-flag"}, ExitValidation},
+		{[]string{"to-gregorian", "1403/05"}, ExitValidation},
+		{[]string{"to-gregorian", "1403-13-01"}, ExitOutOfRange},
+		{[]string{"from-gregorian", "2023-02-29"}, ExitOutOfRange},
+		{[]string{"-m", "13"}, ExitOutOfRange},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.args), func(t *testing.T) {
+			_, err := execute(t, tt.args...)
+			if got := ExitCode(err); got != tt.want {
+				t.Errorf("scal %v: exit code %d (%v), want %d", tt.args, got, err, tt.want)
+			}
+		})
+	}
+}
+
+// failingWriter fails every write, like stdout closed by the reader of a pipe
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("broken pipe")
+}
+
+func TestIOExitCode(t *testing.T) {
+	for _, args := range [][]string{
+		{"to-gregorian", "1403-05-12"},
+		{"convert", "2024-08-02"},
+		{"convert", "1403-05-12", "--from", "jalali"},
+	} {
+		t.Run(strings.Join(args, " "), func(t *testing.T) {
+			t.Cleanup(func() { resetFlags(rootCmd) })
+			rootCmd.SetOut(failingWriter{})
+			rootCmd.SetArgs(args)
+
+			if got := ExitCode(rootCmd.Execute()); got != ExitIO {
+				t.Errorf("exit code %d writing to a failing output, want %d", got, ExitIO)
+			}
+		})
+	}
+}
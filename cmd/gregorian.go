@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alizmhdi/shamsi-calendar/calendar"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	gregorianYear  int
+	gregorianMonth int
+	annotateJalali bool
+)
+
+var gregorianCmd = &cobra.Command{
+	Use:   "gregorian",
+	Short: "List the Jalali date of each day of a Gregorian month",
+	Long: `List the Jalali date of each day of a Gregorian month.
+
+A Gregorian month overlaps two Jalali months. --annotate-jalali-in-gregorian
+puts a separator naming the Jalali month above the day it begins on.`,
+	Example: "  scal gregorian -y 2024 -m 7\n  scal gregorian --annotate-jalali-in-gregorian",
+	Args:    exactArgs(0),
+	RunE:    runGregorian,
+}
+
+func init() {
+	gregorianCmd.Flags().IntVarP(&gregorianYear, "year", "y", 0, "Gregorian year (default: current year)")
+	gregorianCmd.Flags().IntVarP(&gregorianMonth, "month", "m", 0, "Gregorian month (1-12, default: current month)")
+	gregorianCmd.Flags().BoolVar(&annotateJalali, "annotate-jalali-in-gregorian", false, "mark the day each Jalali month begins on with a separator")
+	rootCmd.AddCommand(gregorianCmd)
+}
+
+func runGregorian(cmd *cobra.Command, args []string) error {
+	now := currentTime()
+	if !cmd.Flags().Changed("year") {
+		gregorianYear = now.Year()
+	}
+	if !cmd.Flags().Changed("month") {
+		gregorianMonth = int(now.Month())
+	}
+
+	if err := validateGregorianMonth(gregorianYear, gregorianMonth); err != nil {
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+
+	locale, err := parseLocale()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+
+	return wrapDisplayError(calendar.DisplayGregorianMonth(gregorianYear, gregorianMonth, calendar.Options{
+		Today:          getCurrentJalaliDate(),
+		Locale:         locale,
+		MarkMonthStart: annotateJalali,
+		Output:         cmd.OutOrStdout(),
+	}))
+}
+
+// validateGregorianMonth checks that a Gregorian month lies wholly within the
+// Jalali years the calendar supports, whose Gregorian years bound the year
+func validateGregorianMonth(year, month int) error {
+	if month < 1 || month > 12 {
+		return fmt.Errorf("month must be between 1 and 12: %w", calendar.ErrOutOfRange)
+	}
+
+	first, _, _ := calendar.JalaliToGregorian(minYear, minMonth, 1)
+	last, _, _ := calendar.JalaliToGregorian(maxYear, maxMonth, calendar.GetDaysInMonth(maxYear, maxMonth))
+	if year < first || year > last {
+		return fmt.Errorf("year must be between %d and %d: %w", first, last, calendar.ErrOutOfRange)
+	}
+
+	// The first and last Gregorian years are only partly covered
+	daysInMonth := time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	for _, day := range []int{1, daysInMonth} {
+		if err := calendar.ValidateGregorian(year, month, day); err != nil {
+			return err
+		}
+	}
+	return nil
+}
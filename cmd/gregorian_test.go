@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/alizmhdi/shamsi-calendar/calendar"
+)
+
+func TestGregorianRange(t *testing.T) {
+	valid := []struct{ year, month int }{
+		{2024, 7},
+		// Gregorian years past the last Jalali year, 3177
+		{3500, 1},
+		// the first and last Gregorian months wholly within Jalali 1 to 3177
+		{622, 4},
+		{3799, 2},
+	}
+	for _, tt := range valid {
+		t.Run(fmt.Sprintf("%d-%02d", tt.year, tt.month), func(t *testing.T) {
+			out, err := execute(t, "gregorian", "-y", fmt.Sprint(tt.year), "-m", fmt.Sprint(tt.month))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := fmt.Sprintf("%d-%02d-01", tt.year, tt.month); !strings.Contains(out, want) {
+				t.Errorf("output does not list %s:\n%s", want, out)
+			}
+		})
+	}
+
+	invalid := []struct{ year, month int }{
+		{2024, 13},
+		{2024, 0},
+		{621, 12},
+		{3800, 1},
+		// Jalali year 1 begins on 622-03-22 and 3177 ends in March 3799
+		{622, 3},
+		{3799, 3},
+	}
+	for _, tt := range invalid {
+		t.Run(fmt.Sprintf("%d-%02d", tt.year, tt.month), func(t *testing.T) {
+			_, err := execute(t, "gregorian", "-y", fmt.Sprint(tt.year), "-m", fmt.Sprint(tt.month))
+			if !errors.Is(err, calendar.ErrOutOfRange) || ExitCode(err) != ExitOutOfRange {
+				t.Errorf("error %v (exit code %d), want %v with exit code %d", err, ExitCode(err), calendar.ErrOutOfRange, ExitOutOfRange)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHighlightWarnings(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{
+			name: "hidden",
+			args: []string{"-y", "1404", "-m", "1", "--highlight", "1404-02-10"},
+			want: []string{"scal: warning: --highlight 1404-02-10 is not in the displayed months\n"},
+		},
+		{
+			name: "shown by the three month view",
+			args: []string{"-y", "1404", "-m", "1", "-3", "--highlight", "1404-02-10"},
+		},
+		{
+			name: "given twice",
+			args: []string{"-y", "1404", "-m", "1", "--highlight", "1404-01-20,1404-01-20"},
+			want: []string{"scal: warning: --highlight 1404-01-20 is given more than once\n"},
+		},
+		{
+			name: "overlapping",
+			args: []string{"-y", "1404", "-m", "1", "--highlight", "1404-01-05", "--highlight-range", "1404-01-03..1404-01-06",
+				"--highlight-day-of-month", "5", "--highlight-nowruz-week"},
+			want: []string{"scal: warning: --highlight 1404-01-05 is already highlighted by --highlight-range, --highlight-day-of-month, --highlight-nowruz-week\n"},
+		},
+		{
+			name: "quiet",
+			args: []string{"-y", "1404", "-m", "1", "--highlight", "1404-02-10", "--highlight-nowruz-week", "--highlight", "1404-01-02", "-q"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setClock(t, pinnedNow)
+			_, stderr, err := executeWithStderr(t, append([]string{"--utc"}, tt.args...)...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := stderr, strings.Join(tt.want, ""); got != want {
+				t.Errorf("stderr %q, want %q", got, want)
+			}
+		})
+	}
+}
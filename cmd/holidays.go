@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/alizmhdi/shamsi-calendar/calendar"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	holidaysYear      int
+	holidaysJSON      bool
+	holidaysListFiles []string
+)
+
+var holidaysCmd = &cobra.Command{
+	Use:   "holidays",
+	Short: "List the official holidays of a year",
+	Long: `List every official holiday of a year in date order, with its Jalali and
+Gregorian dates, weekday and name. Year specific (lunar) holidays are included
+for the years a --holidays-file is loaded for.`,
+	Example: "  scal holidays -y 1403\n  scal holidays --json --holidays-file 1403.json",
+	Args:    exactArgs(0),
+	RunE:    runHolidays,
+}
+
+func init() {
+	holidaysCmd.Flags().IntVarP(&holidaysYear, "year", "y", 0, "year to list (default: current year)")
+	holidaysCmd.Flags().BoolVar(&holidaysJSON, "json", false, "print the holidays as JSON")
+	holidaysCmd.Flags().StringArrayVar(&holidaysListFiles, "holidays-file", nil, "load year specific (lunar) holidays from a JSON file")
+	rootCmd.AddCommand(holidaysCmd)
+}
+
+// holidayJSON describes a holiday in the JSON output of the holidays command
+type holidayJSON struct {
+	Date        calendar.JalaliDate `json:"date"`
+	Gregorian   string              `json:"gregorian"`
+	Weekday     string              `json:"weekday"`
+	Name        string              `json:"name"`
+	PersianName string              `json:"name_fa,omitempty"`
+}
+
+// newHolidayJSON describes a holiday falling in year
+func newHolidayJSON(year int, h calendar.Holiday) holidayJSON {
+	date := calendar.JalaliDate{Year: year, Month: h.Month, Day: h.Day}
+	gy, gm, gd := calendar.JalaliToGregorian(date.Year, date.Month, date.Day)
+	return holidayJSON{
+		Date:        date,
+		Gregorian:   fmt.Sprintf("%04d-%02d-%02d", gy, gm, gd),
+		Weekday:     calendar.WeekdayName(calendar.GetDayOfWeek(date.Year, date.Month, date.Day)),
+		Name:        h.Name,
+		PersianName: h.PersianName,
+	}
+}
+
+func runHolidays(cmd *cobra.Command, args []string) error {
+	if !cmd.Flags().Changed("year") {
+		holidaysYear = getCurrentJalaliDate().Year
+	}
+	if err := validateInput(holidaysYear, minMonth); err != nil {
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+	locale, err := parseLocale()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+	if err := loadHolidayFiles(holidaysListFiles); err != nil {
+		return err
+	}
+
+	holidays := calendar.YearHolidays(holidaysYear)
+	list := make([]holidayJSON, len(holidays))
+	for i, h := range holidays {
+		list[i] = newHolidayJSON(holidaysYear, h)
+	}
+
+	out := cmd.OutOrStdout()
+	if holidaysJSON {
+		data, _ := json.MarshalIndent(list, "", "  ")
+		if _, err := fmt.Fprintf(out, "%s\n", data); err != nil {
+			return fmt.Errorf("%w: %w", ErrIO, err)
+		}
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	for i, h := range holidays {
+		weekday := calendar.GetDayOfWeek(holidaysYear, h.Month, h.Day)
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", list[i].Date, list[i].Gregorian, locale.WeekdayName(weekday), h.LocalName(locale))
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("%w: %w", ErrIO, err)
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/alizmhdi/shamsi-calendar/calendar"
+)
+
+// dayJSON describes a single calendar cell
+type dayJSON struct {
+	Day     int    `json:"day"`
+	Today   bool   `json:"today"`
+	Holiday bool   `json:"holiday"`
+	Weekday string `json:"weekday"`
+}
+
+// monthJSON describes a month grid. Weeks holds either []*dayJSON rows, with
+// null for empty cells, or plain day number rows in compact form.
+type monthJSON struct {
+	Year  int         `json:"year"`
+	Month int         `json:"month"`
+	Name  string      `json:"name"`
+	Today string      `json:"today,omitempty"`
+	Weeks interface{} `json:"weeks"`
+	// Holidays is only included with --holidays, Events with --events-file
+	Holidays []holidayJSON `json:"holidays,omitempty"`
+	Events   []eventJSON   `json:"events,omitempty"`
+	// Stats is only included with --stats
+	Stats *calendar.MonthStats `json:"stats,omitempty"`
+}
+
+// renderJSON returns the JSON form of a display mode. Multi-month modes produce
+// an array of months; the summary mode produces its holiday map.
+func renderJSON(mode displayMode, opts calendar.Options, compact bool) string {
+	if mode == modeSummary {
+		return yearSummaryJSON(yearFlag)
+	}
+
+	// --no-today leaves today unmarked, as in the text views
+	today := opts.Today
+	if opts.NoToday {
+		today = calendar.JalaliDate{}
+	}
+
+	var months []monthJSON
+	switch mode {
+	case modeSingleMonth:
+		months = append(months, newMonthJSON(yearFlag, monthFlag, today, compact))
+	case modeThreeMonths, modeAhead, modeSpan:
+		first, last := monthOffsets(mode)
+		for delta := first; delta <= last; delta++ {
+			year, month := calendar.ShiftMonth(yearFlag, monthFlag, delta)
+			months = append(months, newMonthJSON(year, month, today, compact))
+		}
+	case modeFullYear, modeTwoYears:
+		years := 1
+		if mode == modeTwoYears {
+			years = 2
+		}
+		for year := yearFlag; year < yearFlag+years; year++ {
+			for month := minMonth; month <= maxMonth; month++ {
+				months = append(months, newMonthJSON(year, month, today, compact))
+			}
+		}
+	}
+
+	// The sections listed below the calendar in text go into each month's object
+	for i := range months {
+		year, month := months[i].Year, months[i].Month
+		if opts.Holidays {
+			for day := 1; day <= calendar.GetDaysInMonth(year, month); day++ {
+				for _, h := range calendar.HolidaysOn(calendar.JalaliDate{Year: year, Month: month, Day: day}) {
+					months[i].Holidays = append(months[i].Holidays, newHolidayJSON(year, h))
+				}
+			}
+		}
+		monthRange := calendar.DateRange{
+			Start: calendar.JalaliDate{Year: year, Month: month, Day: 1},
+			End:   calendar.JalaliDate{Year: year, Month: month, Day: calendar.GetDaysInMonth(year, month)},
+		}
+		for _, o := range calendar.ExpandEvents(opts.Events, monthRange) {
+			months[i].Events = append(months[i].Events, eventJSON{Date: o.Date, Name: o.Name})
+		}
+		if opts.Stats {
+			stats := calendar.GetMonthStatsWithWeekend(year, month, opts.Weekend)
+			months[i].Stats = &stats
+		}
+	}
+
+	var data []byte
+	if len(months) == 1 {
+		data, _ = json.MarshalIndent(months[0], "", "  ")
+	} else {
+		data, _ = json.MarshalIndent(months, "", "  ")
+	}
+	return string(data) + "\n"
+}
+
+// newMonthJSON builds the JSON description of a month. A zero today marks no day.
+func newMonthJSON(year, month int, today calendar.JalaliDate, compact bool) monthJSON {
+	grid := calendar.GetMonthCalendar(year, month)
+	m := monthJSON{Year: year, Month: month, Name: calendar.MonthName(month)}
+	if today != (calendar.JalaliDate{}) {
+		m.Today = today.String()
+	}
+	if compact {
+		m.Weeks = grid
+		return m
+	}
+
+	weeks := make([][]*dayJSON, len(grid))
+	for i, week := range grid {
+		weeks[i] = make([]*dayJSON, len(week))
+		for weekday, day := range week {
+			if day == 0 {
+				continue
+			}
+			date := calendar.JalaliDate{Year: year, Month: month, Day: day}
+			weeks[i][weekday] = &dayJSON{
+				Day:     day,
+				Today:   date == today,
+				Holiday: calendar.IsHoliday(date),
+				Weekday: calendar.WeekdayName(weekday),
+			}
+		}
+	}
+	m.Weeks = weeks
+	return m
+}
+
+// yearSummaryJSON returns a JSON object mapping each month name, in calendar
+// order, to the days of that month that are holidays
+func yearSummaryJSON(year int) string {
+	entries := make([]string, 0, maxMonth)
+	for month := minMonth; month <= maxMonth; month++ {
+		name, _ := json.Marshal(calendar.MonthName(month))
+		days, _ := json.Marshal(calendar.MonthHolidays(year, month))
+		entries = append(entries, fmt.Sprintf("  %s: %s", name, days))
+	}
+	return "{\n" + strings.Join(entries, ",\n") + "\n}\n"
+}
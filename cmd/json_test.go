@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestJSONNoToday(t *testing.T) {
+	setClock(t, pinnedNow)
+
+	for _, flag := range []string{"--json", "--json-compact"} {
+		t.Run(flag, func(t *testing.T) {
+			out, err := execute(t, flag)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(out, `"today": "1403-05-12"`) {
+				t.Errorf("output does not name today:\n%s", out)
+			}
+		})
+		t.Run(flag+" --no-today", func(t *testing.T) {
+			out, err := execute(t, flag, "--no-today")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if strings.Contains(out, `"today": true`) || strings.Contains(out, "1403-05-12") {
+				t.Errorf("output marks today despite --no-today:\n%s", out)
+			}
+		})
+	}
+
+	t.Run("three months", func(t *testing.T) {
+		out, err := execute(t, "--json", "-3", "--no-today")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(out, `"today": true`) || strings.Contains(out, "1403-05-12") {
+			t.Errorf("output marks today despite --no-today:\n%s", out)
+		}
+	})
+}
+
+// jsonMonths returns the year-month of each month in a multi-month --json output
+func jsonMonths(t *testing.T, out string) []string {
+	t.Helper()
+	var months []struct{ Year, Month int }
+	if err := json.Unmarshal([]byte(out), &months); err != nil {
+		t.Fatalf("%v in %s", err, out)
+	}
+	shown := make([]string, len(months))
+	for i, m := range months {
+		shown[i] = fmt.Sprintf("%d-%02d", m.Year, m.Month)
+	}
+	return shown
+}
@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"os"
+	"strings"
+
+	"github.com/alizmhdi/shamsi-calendar/calendar"
+)
+
+// parseLocale returns the locale selected by --locale and --en-style.
+// "auto" picks Persian when the environment's locale is Persian and English otherwise.
+func parseLocale() (calendar.Locale, error) {
+	lang := localeFlag
+	if lang == "auto" {
+		lang = environmentLanguage()
+	}
+	return calendar.ParseLocale(lang, enStyleFlag)
+}
+
+// environmentLanguage returns "fa" when the first of LC_ALL, LC_MESSAGES and LANG
+// that is set names a Persian locale, such as fa_IR.UTF-8, and "en" otherwise
+func environmentLanguage() string {
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		value := os.Getenv(name)
+		if value == "" {
+			continue
+		}
+		if value == "fa" || strings.HasPrefix(value, "fa_") || strings.HasPrefix(value, "fa.") || strings.HasPrefix(value, "fa@") {
+			return "fa"
+		}
+		return "en"
+	}
+	return "en"
+}
@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+)
+
+// parseLocation returns the time zone selected by --utc, --local or --tz, which
+// decides the current date. Without any of them it is the local time zone.
+func parseLocation() (*time.Location, error) {
+	given := 0
+	for _, set := range []bool{utcFlag, localFlag, tzFlag != ""} {
+		if set {
+			given++
+		}
+	}
+	if given > 1 {
+		return nil, fmt.Errorf("--utc, --local and --tz cannot be used together")
+	}
+
+	switch {
+	case utcFlag:
+		return time.UTC, nil
+	case tzFlag != "":
+		loc, err := time.LoadLocation(tzFlag)
+		if err != nil {
+			return nil, fmt.Errorf("--tz: %v", err)
+		}
+		return loc, nil
+	default:
+		return time.Local, nil
+	}
+}
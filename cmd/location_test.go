@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseLocation(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    string
+		wantErr string
+	}{
+		{"default", nil, time.Local.String(), ""},
+		{"utc", []string{"--utc"}, "UTC", ""},
+		{"local", []string{"--local"}, time.Local.String(), ""},
+		{"tz", []string{"--tz", "Asia/Tehran"}, "Asia/Tehran", ""},
+		{"unknown tz", []string{"--tz", "Mars/Olympus"}, "", "--tz"},
+		{"utc and local", []string{"--utc", "--local"}, "", "cannot be used together"},
+		{"utc and tz", []string{"--utc", "--tz", "Asia/Tehran"}, "", "cannot be used together"},
+		{"local and tz", []string{"--local", "--tz", "Asia/Tehran"}, "", "cannot be used together"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setClock(t, pinnedNow)
+			_, err := execute(t, append(tt.args, "--iso-today")...)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error %v, want one mentioning %q", err, tt.wantErr)
+				}
+				if code := ExitCode(err); code != ExitValidation {
+					t.Errorf("exit code %d, want %d", code, ExitValidation)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := location.String(); got != tt.want {
+				t.Errorf("location %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
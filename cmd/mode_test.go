@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// modeArgs are the arguments selecting each display mode
+var modeArgs = map[string][]string{
+	"summary":   {"--summary"},
+	"two-years": {"--two-years"},
+	"full-year": {"-Y"},
+	"three":     {"-3"},
+	"ahead":     {"--ahead", "3"},
+	"span":      {"--span", "-1..+1"},
+}
+
+func TestConflictingDisplayModes(t *testing.T) {
+	for i, first := range modeFlags {
+		for _, second := range modeFlags[i+1:] {
+			t.Run(first.name+"+"+second.name, func(t *testing.T) {
+				setClock(t, pinnedNow)
+				args := append(append([]string{"--utc", "-y", "1403"}, modeArgs[first.name]...), modeArgs[second.name]...)
+				_, err := execute(t, args...)
+				if !errors.Is(err, ErrValidation) {
+					t.Fatalf("%v: error %v, want a validation error", args, err)
+				}
+				if code := ExitCode(err); code != ExitValidation {
+					t.Errorf("%v: exit code %d, want %d", args, code, ExitValidation)
+				}
+				want := "--" + first.name + " and --" + second.name + " cannot be used together"
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("%v: error %q, want it to say %q", args, err, want)
+				}
+			})
+		}
+	}
+}
+
+func TestDisplayModeCombinations(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{"three modes", []string{"-3", "-Y", "--summary"}, "--summary, --full-year and --three cannot be used together"},
+		{"false boolean", []string{"-3", "--full-year=false"}, ""},
+		// -y alone selects the year view, but only picks the year for the other modes
+		{"three with year", []string{"-3", "-y", "1403"}, ""},
+		{"ahead with year and month", []string{"--ahead", "2", "-y", "1403", "-m", "5"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setClock(t, pinnedNow)
+			_, err := execute(t, append([]string{"--utc"}, tt.args...)...)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("%v: %v", tt.args, err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrValidation) || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("%v: error %v, want a validation error saying %q", tt.args, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/alizmhdi/shamsi-calendar/calendar"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	monthYear     int
+	monthMonth    int
+	monthPNG      bool
+	monthOut      string
+	monthHolidays bool
+)
+
+var calendarMonthCmd = &cobra.Command{
+	Use:   "calendar-month",
+	Short: "Display a single month, optionally as a PNG image",
+	Long: `Display a single Jalali month.
+
+With --png the month is drawn as an image and written to --out instead, with
+today and (with --holidays) the official holidays highlighted. Its font has
+Latin letters only, so --png does not work with --locale fa.`,
+	Example: "  scal calendar-month -y 1403 -m 1 --png --out farvardin.png",
+	Args:    exactArgs(0),
+	RunE:    runCalendarMonth,
+}
+
+func init() {
+	calendarMonthCmd.Flags().IntVarP(&monthYear, "year", "y", 0, "year to display (default: current year)")
+	calendarMonthCmd.Flags().IntVarP(&monthMonth, "month", "m", 0, "month to display (1-12, default: current month)")
+	calendarMonthCmd.Flags().BoolVar(&monthPNG, "png", false, "write the month as a PNG image to --out")
+	calendarMonthCmd.Flags().StringVar(&monthOut, "out", "month.png", "file the PNG image is written to")
+	calendarMonthCmd.Flags().BoolVar(&monthHolidays, "holidays", false, "highlight official holidays")
+	rootCmd.AddCommand(calendarMonthCmd)
+}
+
+func runCalendarMonth(cmd *cobra.Command, args []string) error {
+	currentJalali := getCurrentJalaliDate()
+	if !cmd.Flags().Changed("year") {
+		monthYear = currentJalali.Year
+	}
+	if !cmd.Flags().Changed("month") {
+		monthMonth = currentJalali.Month
+	}
+	if err := validateInput(monthYear, monthMonth); err != nil {
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+
+	locale, err := parseLocale()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+
+	opts := calendar.Options{Today: currentJalali, Holidays: monthHolidays, Locale: locale}
+	if !monthPNG {
+		if cmd.Flags().Changed("out") {
+			return fmt.Errorf("%w: --out requires --png", ErrValidation)
+		}
+		return wrapDisplayError(calendar.DisplayMonthTable(monthYear, monthMonth, opts))
+	}
+
+	if locale == calendar.LocalePersian {
+		// Checked before creating the file, so that no empty image is left behind
+		return fmt.Errorf("%w: %w; use --locale en", ErrValidation, calendar.ErrNoPersianFont)
+	}
+	f, err := os.Create(monthOut)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrIO, err)
+	}
+	if err := calendar.RenderMonthPNG(f, monthYear, monthMonth, opts); err != nil {
+		f.Close()
+		return wrapDisplayError(err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("%w: %w", ErrIO, err)
+	}
+	return nil
+}
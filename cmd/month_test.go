@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alizmhdi/shamsi-calendar/calendar"
+)
+
+func TestCalendarMonthPNG(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "month.png")
+
+	if _, err := execute(t, "calendar-month", "-y", "1403", "-m", "5", "--png", "--locale", "en", "--out", out); err != nil {
+		t.Fatal(err)
+	}
+	if info, err := os.Stat(out); err != nil || info.Size() == 0 {
+		t.Fatalf("no image written to %s: %v", out, err)
+	}
+}
+
+func TestCalendarMonthPNGPersian(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "month.png")
+
+	_, err := execute(t, "calendar-month", "-y", "1403", "-m", "5", "--png", "--locale", "fa", "--out", out)
+	if !errors.Is(err, calendar.ErrNoPersianFont) || ExitCode(err) != ExitValidation {
+		t.Errorf("error %v (exit code %d), want %v with exit code %d", err, ExitCode(err), calendar.ErrNoPersianFont, ExitValidation)
+	}
+	if _, err := os.Stat(out); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("%s was created despite the error", out)
+	}
+}
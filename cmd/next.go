@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/alizmhdi/shamsi-calendar/calendar"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	nextCount    int
+	nextFrom     calendar.JalaliDate
+	nextRelative bool
+)
+
+var nextWeekdayCmd = &cobra.Command{
+	Use:   "next-weekday WEEKDAY",
+	Short: "List the next dates falling on a weekday",
+	Long: `List the next dates falling on WEEKDAY, starting from today.
+
+WEEKDAY is a weekday name such as jome, panjshanbe or جمعه.
+If the start date itself falls on WEEKDAY it is the first date listed.
+--relative adds how far each date is from today, such as "in 3 days".`,
+	Example: "  scal next-weekday jome --count 5\n  scal next-weekday shanbe --from 1403-07-01",
+	Args:    exactArgs(1),
+	RunE:    runNextWeekday,
+}
+
+func init() {
+	nextWeekdayCmd.Flags().IntVar(&nextCount, "count", 1, "number of dates to list")
+	nextWeekdayCmd.Flags().BoolVar(&nextRelative, "relative", false, "add how far each date is from today, e.g. in 3 days")
+	nextWeekdayCmd.Flags().Var((*calendar.JalaliDateValue)(&nextFrom), "from", "start from this Jalali date (YYYY-MM-DD) instead of today")
+	rootCmd.AddCommand(nextWeekdayCmd)
+}
+
+func runNextWeekday(cmd *cobra.Command, args []string) error {
+	weekday, err := calendar.ParseWeekdayName(args[0])
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+	locale, err := parseLocale()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+	if nextCount < 1 {
+		return fmt.Errorf("%w: --count must be at least 1", ErrValidation)
+	}
+
+	today := getCurrentJalaliDate()
+	date := today
+	if cmd.Flags().Changed("from") {
+		date = nextFrom
+	}
+
+	// Move to the first matching day, then step a week at a time
+	date = date.AddDays((weekday - calendar.GetDayOfWeek(date.Year, date.Month, date.Day) + 7) % 7)
+	for i := 0; i < nextCount; i++ {
+		line := fmt.Sprintf("%s  %s  %d %s %d", date, locale.WeekdayName(weekday), date.Day, locale.MonthName(date.Month), date.Year)
+		if nextRelative {
+			line += "  " + calendar.HumanizeInterval(calendar.DaysBetween(today, date), locale)
+		}
+		_, err := fmt.Fprintln(cmd.OutOrStdout(), line)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrIO, err)
+		}
+		date = date.AddDays(7)
+	}
+	return nil
+}
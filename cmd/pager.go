@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// defaultPager is used when $PAGER is not set; -R keeps the colors intact
+const defaultPager = "less -R"
+
+// writeOutput prints the rendered calendar to out, paging it when forced or when
+// it does not fit the terminal. Without a usable pager it is written to out directly.
+func writeOutput(out io.Writer, output string, forcePager bool) error {
+	if forcePager || exceedsTerminal(out, output) {
+		if paged, err := runPager(out, output); paged {
+			return err
+		}
+	}
+
+	_, err := io.WriteString(out, output)
+	return err
+}
+
+// exceedsTerminal reports whether out is a terminal and output is taller than it
+func exceedsTerminal(out io.Writer, output string) bool {
+	f, ok := out.(*os.File)
+	if !ok || !isTerminal(f) {
+		return false
+	}
+
+	_, height, ok := terminalSize()
+	return ok && height > 0 && strings.Count(output, "\n") > height
+}
+
+// runPager writes output to the stdin of $PAGER, which writes to out. It reports
+// false when no pager is available.
+func runPager(out io.Writer, output string) (bool, error) {
+	args := strings.Fields(os.Getenv("PAGER"))
+	if len(args) == 0 {
+		args = strings.Fields(defaultPager)
+	}
+
+	path, err := exec.LookPath(args[0])
+	if err != nil {
+		return false, nil
+	}
+
+	pager := exec.Command(path, args[1:]...)
+	pager.Stdin = strings.NewReader(output)
+	pager.Stdout = out
+	pager.Stderr = os.Stderr
+	return true, pager.Run()
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"bytes"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestWriteOutput(t *testing.T) {
+	const output = "Mordad 1403\n"
+
+	tests := []struct {
+		name  string
+		pager string
+		force bool
+	}{
+		{"not a terminal", "", false},
+		{"through the pager", "cat", true},
+		{"pager with arguments", "cat -u", true},
+		{"no pager available", "no-such-pager", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if strings.HasPrefix(tt.pager, "cat") {
+				if _, err := exec.LookPath("cat"); err != nil {
+					t.Skip("cat is not available")
+				}
+			}
+			t.Setenv("PAGER", tt.pager)
+
+			var out bytes.Buffer
+			if err := writeOutput(&out, output, tt.force); err != nil {
+				t.Fatalf("writeOutput: %v", err)
+			}
+			if out.String() != output {
+				t.Errorf("wrote %q, want %q", out.String(), output)
+			}
+		})
+	}
+}
+
+func TestCalendarWritesToCommandOutput(t *testing.T) {
+	t.Setenv("PAGER", "cat")
+	out, err := execute(t, "-y", "1403", "-m", "5", "--pager")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "Mordad 1403") {
+		t.Errorf("output does not show Mordad 1403:\n%s", out)
+	}
+}
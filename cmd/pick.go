@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/alizmhdi/shamsi-calendar/calendar"
+)
+
+// pickDay renders a month and asks for a day until a valid one is entered.
+// The calendar and prompts go to prompt so that only the chosen date's
+// details are written to out, keeping the result easy to capture in scripts.
+//
+// Typing g followed by a date, as in "g 1403/07/01", jumps to that date's month
+// with the date highlighted; an empty answer then picks it. A date that does
+// not parse is reported and the prompt is shown again.
+func pickDay(in io.Reader, prompt, out io.Writer, year, month int, opts calendar.Options) error {
+	fmt.Fprint(prompt, calendar.RenderMonth(year, month, opts))
+
+	var selected calendar.JalaliDate
+	scanner := bufio.NewScanner(in)
+	for {
+		days := calendar.GetDaysInMonth(year, month)
+		if selected == (calendar.JalaliDate{}) {
+			fmt.Fprintf(prompt, "Day (1-%d, g DATE to go to a date): ", days)
+		} else {
+			fmt.Fprintf(prompt, "Day (1-%d, Enter for %d): ", days, selected.Day)
+		}
+		if !scanner.Scan() {
+			if err := scanner.Err(); err != nil {
+				return fmt.Errorf("%w: %w", ErrIO, err)
+			}
+			return fmt.Errorf("%w: no day selected", ErrValidation)
+		}
+		answer := strings.TrimSpace(scanner.Text())
+
+		if target, ok := gotoTarget(answer); ok {
+			date, err := calendar.ParseJalali(target)
+			if err != nil {
+				fmt.Fprintf(prompt, "Cannot go to %q: %v\n", target, err)
+				continue
+			}
+			selected, year, month = date, date.Year, date.Month
+			jumped := opts
+			jumped.Highlight = append(append([]calendar.JalaliDate{}, opts.Highlight...), date)
+			fmt.Fprint(prompt, calendar.RenderMonth(year, month, jumped))
+			continue
+		}
+
+		if answer == "" && selected != (calendar.JalaliDate{}) {
+			return printDayDetails(out, selected, opts.Locale)
+		}
+		day, err := strconv.Atoi(answer)
+		if err != nil || day < 1 || day > days {
+			fmt.Fprintf(prompt, "Please enter a day between 1 and %d\n", days)
+			continue
+		}
+
+		return printDayDetails(out, calendar.JalaliDate{Year: year, Month: month, Day: day}, opts.Locale)
+	}
+}
+
+// gotoTarget returns the date typed after the g command, as in "g 1403/07/01"
+// or "g1403/07/01"; ok is false when answer is not a g command
+func gotoTarget(answer string) (target string, ok bool) {
+	rest, found := strings.CutPrefix(answer, "g")
+	if !found {
+		return "", false
+	}
+	return strings.TrimSpace(rest), true
+}
+
+// printDayDetails writes the Jalali and Gregorian forms of a date with its weekday
+// and holidays, naming the month, weekday and holidays in the locale
+func printDayDetails(out io.Writer, date calendar.JalaliDate, locale calendar.Locale) error {
+	gy, gm, gd := calendar.JalaliToGregorian(date.Year, date.Month, date.Day)
+
+	holiday := "no"
+	if holidays := calendar.HolidaysOn(date); len(holidays) > 0 {
+		names := make([]string, len(holidays))
+		for i, h := range holidays {
+			names[i] = h.LocalName(locale)
+		}
+		holiday = strings.Join(names, ", ")
+	}
+
+	_, err := fmt.Fprintf(out, "Jalali:    %s (%d %s %d)\nGregorian: %04d-%02d-%02d\nWeekday:   %s\nHoliday:   %s\n",
+		date, date.Day, locale.MonthName(date.Month), date.Year,
+		gy, gm, gd,
+		locale.WeekdayName(calendar.GetDayOfWeek(date.Year, date.Month, date.Day)),
+		holiday)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrIO, err)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSelectDay(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []string
+		input  string
+		want   string
+		prompt string
+	}{
+		{
+			name:  "day",
+			input: "12\n",
+			want:  "Jalali:    1403-01-12 (12 Farvardin 1403)\nGregorian: 2024-03-31\nWeekday:   Yek\nHoliday:   Islamic Republic Day\n",
+		},
+		{
+			name:   "invalid days are asked again",
+			input:  "0\nabc\n32\n5\n",
+			want:   "Jalali:    1403-01-05 (5 Farvardin 1403)\nGregorian: 2024-03-24\nWeekday:   Yek\nHoliday:   no\n",
+			prompt: "Please enter a day between 1 and 31\n",
+		},
+		{
+			name:  "persian",
+			args:  []string{"--locale", "fa"},
+			input: "12\n",
+			want:  "Jalali:    1403-01-12 (12 فروردین 1403)\nGregorian: 2024-03-31\nWeekday:   یکشنبه\nHoliday:   روز جمهوری اسلامی\n",
+		},
+		{
+			name:   "goto picks the date",
+			input:  "g 1403/13/01\ng 1404-07-10\n\n",
+			want:   "Jalali:    1404-07-10 (10 Mehr 1404)\nGregorian: 2025-10-02\nWeekday:   Panj\nHoliday:   no\n",
+			prompt: "Cannot go to \"1403/13/01\"",
+		},
+		{
+			name:   "goto then another day",
+			input:  "g1404/07/10\n3\n",
+			want:   "Jalali:    1404-07-03 (3 Mehr 1404)\nGregorian: 2025-09-25\nWeekday:   Panj\nHoliday:   no\n",
+			prompt: "Day (1-30, Enter for 10): ",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setClock(t, pinnedNow)
+			setInput(t, tt.input)
+			out, prompt, err := executeWithStderr(t, append([]string{"--utc", "--select-day", "-y", "1403", "-m", "1"}, tt.args...)...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out != tt.want {
+				t.Errorf("printed %q, want %q", out, tt.want)
+			}
+			if !strings.Contains(prompt, " 1403") || !strings.Contains(prompt, tt.prompt) {
+				t.Errorf("prompt lacks the month or %q:\n%s", tt.prompt, prompt)
+			}
+		})
+	}
+}
+
+func TestSelectDayNoAnswer(t *testing.T) {
+	setClock(t, pinnedNow)
+	setInput(t, "40\n")
+	out, _, err := executeWithStderr(t, "--utc", "--select-day")
+	if !errors.Is(err, ErrValidation) || strings.Contains(out, "Jalali:") {
+		t.Errorf("printed %q with error %v, want no date and a validation error", out, err)
+	}
+}
+
+// setInput makes the commands read input as their standard input until the test ends
+func setInput(t *testing.T, input string) {
+	t.Helper()
+	rootCmd.SetIn(strings.NewReader(input))
+	t.Cleanup(func() { rootCmd.SetIn(nil) })
+}
@@ -1,7 +1,15 @@
 package cmd
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/alizmhdi/shamsi-calendar/calendar"
@@ -10,17 +18,82 @@ import (
 )
 
 const (
-	minYear  = 1
-	maxYear  = 9999
+	minYear  = calendar.MinYear
+	maxYear  = calendar.MaxYear
 	minMonth = 1
 	maxMonth = 12
+
+	// maxSpanMonths caps --span at two years of months
+	maxSpanMonths = 2 * maxMonth
+)
+
+var (
+	// ErrValidation reports invalid user input such as an out of range flag value
+	ErrValidation = errors.New("validation error")
+	// ErrIO reports a failure writing the calendar output
+	ErrIO = errors.New("i/o error")
 )
 
 var (
 	yearFlag     int
 	monthFlag    int
+	monthName    string
 	threeFlag    bool
 	fullYearFlag bool
+	colorfulFlag bool
+	pagerFlag    bool
+	twoYearsFlag bool
+	startDayFlag int
+	holidaysFlag bool
+	holidayFiles []string
+	summaryFlag  bool
+	jsonFlag     bool
+	jsonCompact  bool
+	alignFlag    string
+	quarterFlag  bool
+	verboseFlag  bool
+	selectDay    bool
+	encodingFlag string
+	noTodayFlag  bool
+	highlights   []calendar.JalaliDate
+	themeFile    string
+	rangeFlags   []calendar.DateRange
+	nowruzWeek   bool
+	widthFlag    int
+	isoToday     bool
+	eventFiles   []string
+	invertFlag   bool
+	statsFlag    bool
+	localeFlag   string
+	enStyleFlag  string
+	weekdayHighs []string
+	monthDayHigh []int
+	dayAlignFlag string
+	perRowFlag   int
+	todayStyle   string
+	todayColor   string
+	dayCounts    bool
+	adjacentDays bool
+	quietFlag    bool
+	reverseWeeks bool
+	fixedHeight  bool
+	weekendFlag  []string
+	columnsFlag  []string
+	aheadFlag    int
+	showGregYear bool
+	listMonths   bool
+	listWeekdays bool
+	firstWeekday bool
+	firstDayMark bool
+	utcFlag      bool
+	localFlag    bool
+	tzFlag       string
+	spanFlag     string
+	spanFirst    int
+	spanLast     int
+	noPadMonth   bool
+	repeatFlag   int
+	pageBreak    bool
 )
 
 var rootCmd = &cobra.Command{
@@ -33,7 +106,10 @@ Features:
 - Display specific month/year
 - Display entire year
 - Display three months
-- Highlight today's date`,
+- Display two consecutive years
+- Highlight today's date
+- Highlight official holidays
+- Color each weekday column distinctly`,
 	RunE: runCalendar,
 }
 
@@ -42,45 +118,535 @@ func Execute() error {
 }
 
 func init() {
+	rootCmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	})
+
+	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		var err error
+		if location, err = parseLocation(); err != nil {
+			return fmt.Errorf("%w: %w", ErrValidation, err)
+		}
+		return nil
+	}
+
+	rootCmd.PersistentFlags().BoolVar(&utcFlag, "utc", false, "take today's date from UTC")
+	rootCmd.PersistentFlags().BoolVar(&localFlag, "local", false, "take today's date from the local time zone (the default)")
+	rootCmd.PersistentFlags().StringVar(&tzFlag, "tz", "", "take today's date from an IANA time zone, e.g. Asia/Tehran")
+	rootCmd.PersistentFlags().StringVar(&localeFlag, "locale", "auto", "language of month and weekday names: en, fa or auto (from LC_ALL, LC_MESSAGES or LANG)")
+	rootCmd.PersistentFlags().BoolVarP(&quietFlag, "quiet", "q", false, "print no warnings and leave out the holiday and event legends; errors are still reported")
+	rootCmd.PersistentFlags().StringVar(&enStyleFlag, "en-style", "transliteration", "names used by the en locale: transliteration (Farvardin, Shanbe) or english (Mar-Apr, Sat)")
+
 	rootCmd.Flags().IntVarP(&yearFlag, "year", "y", 0, "year to display (default: current year)")
 	rootCmd.Flags().IntVarP(&monthFlag, "month", "m", 0, "month to display (1-12, default: current month)")
+	rootCmd.Flags().StringVar(&monthName, "month-name", "", "month to display by name, e.g. Mordad or مرداد")
 	rootCmd.Flags().BoolVarP(&threeFlag, "three", "3", false, "display three months spanning the date")
+	rootCmd.Flags().IntVar(&aheadFlag, "ahead", 0, "display N months starting with the month, e.g. 3 for it and the next two")
+	rootCmd.Flags().StringVar(&spanFlag, "span", "", "display the months FIRST..LAST away from the month, e.g. -2..+2 for five months")
 	rootCmd.Flags().BoolVarP(&fullYearFlag, "full-year", "Y", false, "display entire year")
+	rootCmd.Flags().BoolVar(&isoToday, "iso-today", false, "print only today's Jalali date as YYYY-MM-DD")
+	rootCmd.Flags().BoolVar(&listMonths, "list-months", false, "print the month names, Farvardin to Esfand, in the locale; with --json as an array")
+	rootCmd.Flags().BoolVar(&listWeekdays, "list-weekdays", false, "print the weekday names, Shanbe to Jome, in the locale; with --json as an array")
+	rootCmd.Flags().BoolVar(&firstWeekday, "first-weekday-of-month", false, "print only the weekday the month starts on, e.g. Mordad 1403 starts on Doshanbe")
+	rootCmd.Flags().StringVar(&todayStyle, "today-style", "bold", "emphasis of today's date: bold, underline or reverse")
+	rootCmd.Flags().StringVar(&todayColor, "today-color", "yellow", "color of today's date: black, red, green, yellow, blue, magenta, cyan, white or #RRGGBB")
+	rootCmd.Flags().BoolVar(&noTodayFlag, "no-today", false, "do not highlight today's date")
+	rootCmd.Flags().Var((*calendar.JalaliDateListValue)(&highlights), "highlight", "highlight the given dates (YYYY-MM-DD, repeatable or comma separated)")
+	rootCmd.Flags().Var((*calendar.DateRangeListValue)(&rangeFlags), "highlight-range", "highlight the dates in START..END (repeatable or comma separated)")
+	rootCmd.Flags().StringSliceVar(&weekdayHighs, "highlight-day-of-week-in-year", nil, "highlight every day on a weekday, given by name or by a date (YYYY-MM-DD) falling on it")
+	rootCmd.Flags().IntSliceVar(&monthDayHigh, "highlight-day-of-month", nil, "highlight this day (1-31) of every month, or the last day of shorter months")
+	rootCmd.Flags().BoolVar(&nowruzWeek, "highlight-nowruz-week", false, "highlight the first week of Farvardin")
+	rootCmd.Flags().BoolVar(&holidaysFlag, "holidays", false, "highlight official holidays")
+	rootCmd.Flags().StringArrayVar(&holidayFiles, "holidays-file", nil, "load year specific (lunar) holidays from a JSON file; implies --holidays")
+	rootCmd.Flags().StringArrayVar(&eventFiles, "events-file", nil, "mark the events, including weekly and monthly ones, from a JSON file")
+	rootCmd.Flags().StringSliceVar(&weekendFlag, "weekend", nil, "weekdays off, colored and counted as the weekend, e.g. Shanbe,Yekshanbe (default Jome)")
+	rootCmd.Flags().StringSliceVar(&columnsFlag, "columns", nil, "show only these weekday columns, by name or as a FIRST..LAST range, e.g. Shanbe..Chaharshanbe")
+	rootCmd.Flags().BoolVar(&statsFlag, "stats", false, "print the weekend, holiday and working day counts below the month")
+	rootCmd.Flags().BoolVar(&summaryFlag, "summary", false, "display one line per month listing its holidays")
+	rootCmd.Flags().BoolVar(&jsonFlag, "json", false, "print the output as JSON")
+	rootCmd.Flags().BoolVar(&jsonCompact, "json-compact", false, "print the output as JSON with plain day numbers (0 for empty cells)")
+	rootCmd.Flags().BoolVar(&twoYearsFlag, "two-years", false, "display the year and the following year")
+	rootCmd.Flags().StringVar(&themeFile, "theme-file", "", "load colors from a theme file")
+	rootCmd.Flags().BoolVar(&invertFlag, "invert", false, "use colors suited to a light terminal background")
+	rootCmd.Flags().BoolVar(&colorfulFlag, "colorful", false, "color each weekday column with a distinct hue")
+	rootCmd.Flags().IntVar(&startDayFlag, "start-day-override", -1, "force the weekday column (0-6) of the first day of each month")
+	_ = rootCmd.Flags().MarkHidden("start-day-override")
+	rootCmd.Flags().StringVar(&alignFlag, "align", "center", "alignment of the month and year headers: left, center or right")
+	rootCmd.Flags().IntVar(&widthFlag, "width", 0, "lay the output out for N columns instead of the terminal width")
+	rootCmd.Flags().StringVar(&dayAlignFlag, "day-align", "center", "alignment of the day numbers in their columns: left, center or right")
+	rootCmd.Flags().IntVar(&perRowFlag, "months-per-row", 0, "months per row in the year view: 1, 2, 3, 4 or 6 (default 3)")
+	rootCmd.Flags().BoolVar(&dayCounts, "day-counts", false, "print the number of days under each month of the year view")
+	rootCmd.Flags().BoolVar(&adjacentDays, "adjacent-days", false, "fill the empty cells of each month with the dimmed days of the previous and next months")
+	rootCmd.Flags().BoolVar(&firstDayMark, "first-day-highlight", false, "mark the days of the first column, where each week starts")
+	rootCmd.Flags().BoolVar(&noPadMonth, "no-pad-month", false, "trim the trailing spaces and blank lines of a single month")
+	rootCmd.Flags().BoolVar(&fixedHeight, "fixed-height", false, "always lay each month out in six week rows, so that months keep the same height")
+	rootCmd.Flags().BoolVar(&reverseWeeks, "reverse-weeks", false, "list the weeks of each month from the last to the first")
+	rootCmd.Flags().BoolVar(&showGregYear, "show-gregorian", false, "add the Gregorian years to the year view header, e.g. 1403 (2024–2025)")
+	rootCmd.Flags().BoolVar(&quarterFlag, "quarter-labels", false, "label each quarter of the year view with its season")
+	rootCmd.Flags().BoolVar(&selectDay, "select-day", false, "display the month and ask for a day (g DATE jumps to another month), then print that date")
+	rootCmd.Flags().BoolVarP(&verboseFlag, "verbose", "v", false, "log conversion details of the displayed months to stderr")
+	rootCmd.Flags().StringVar(&encodingFlag, "output-encoding", "auto", "output encoding: auto, utf8 or ascii")
+	rootCmd.Flags().BoolVar(&pagerFlag, "pager", false, "send output through $PAGER (default: only when it does not fit the terminal)")
+	rootCmd.Flags().IntVar(&repeatFlag, "repeat", 1, "print the calendar N times, each copy on its own page (separated by form feeds)")
+	rootCmd.Flags().BoolVar(&pageBreak, "page-break", false, "end the output with a form feed, so the last copy also ejects its page")
 }
 
 func validateInput(year, month int) error {
 	if month < minMonth || month > maxMonth {
-		return fmt.Errorf("month must be between %d and %d", minMonth, maxMonth)
+		return fmt.Errorf("month must be between %d and %d: %w", minMonth, maxMonth, calendar.ErrOutOfRange)
 	}
 
 	if year < minYear || year > maxYear {
-		return fmt.Errorf("year must be between %d and %d", minYear, maxYear)
+		return fmt.Errorf("year must be between %d and %d: %w", minYear, maxYear, calendar.ErrOutOfRange)
 	}
 
 	return nil
 }
 
-// getCurrentJalaliDate returns the current date in Jalali calendar
+// location is the time zone that decides the current date, so "today" and its
+// weekday always come from the same wall clock. It is set by --utc, --local and --tz.
+var location = time.Local
+
+// clock is the source of the current time, replaced with a calendar.FixedClock
+// to run the commands as of a known date
+var clock calendar.Clock = calendar.SystemClock{}
+
+// currentTime returns the current time in location
+func currentTime() time.Time {
+	return clock.Now().In(location)
+}
+
+// getCurrentJalaliDate returns the current Jalali date in location
 func getCurrentJalaliDate() calendar.JalaliDate {
-	now := time.Now()
-	return calendar.GregorianToJalali(now.Year(), int(now.Month()), now.Day())
+	return calendar.FromTime(currentTime())
+}
+
+// modeFlags are the flags that each select a display mode, in order of precedence
+var modeFlags = []struct {
+	name string
+	mode displayMode
+}{
+	{"summary", modeSummary},
+	{"two-years", modeTwoYears},
+	{"full-year", modeFullYear},
+	{"three", modeThreeMonths},
+	{"ahead", modeAhead},
+	{"span", modeSpan},
+}
+
+// modeFlagSet reports whether a display mode flag was given. Boolean flags only
+// count when true, so --three=false keeps the default mode.
+func modeFlagSet(cmd *cobra.Command, name string) bool {
+	if set, err := cmd.Flags().GetBool(name); err == nil {
+		return set
+	}
+	return cmd.Flags().Changed(name)
 }
 
-// determineDisplayMode determines which display mode to use based on flags
-func determineDisplayMode(cmd *cobra.Command) displayMode {
+// determineDisplayMode determines which display mode to use based on flags.
+// It fails when more than one display mode flag is given.
+func determineDisplayMode(cmd *cobra.Command) (displayMode, error) {
 	yearFlagSet := cmd.Flags().Changed("year")
-	monthFlagSet := cmd.Flags().Changed("month")
+	monthFlagSet := cmd.Flags().Changed("month") || cmd.Flags().Changed("month-name")
+
+	var given []string
+	mode := modeSingleMonth
+	for _, f := range modeFlags {
+		if !modeFlagSet(cmd, f.name) {
+			continue
+		}
+		if len(given) == 0 {
+			mode = f.mode
+		}
+		given = append(given, "--"+f.name)
+	}
+
+	switch {
+	case len(given) > 1:
+		last := len(given) - 1
+		return modeSingleMonth, fmt.Errorf("%s and %s cannot be used together", strings.Join(given[:last], ", "), given[last])
+	case len(given) == 1:
+		return mode, nil
+	case yearFlagSet && !monthFlagSet:
+		return modeFullYear, nil
+	default:
+		return modeSingleMonth, nil
+	}
+}
+
+// buildOptions collects the rendering preferences from the command line flags
+func buildOptions(currentDate calendar.JalaliDate) (calendar.Options, error) {
+	headerAlign, err := calendar.ParseAlignment(alignFlag)
+	if err != nil {
+		return calendar.Options{}, err
+	}
+	dayAlign, err := calendar.ParseAlignment(dayAlignFlag)
+	if err != nil {
+		return calendar.Options{}, fmt.Errorf("--day-align: %v", err)
+	}
+	locale, err := parseLocale()
+	if err != nil {
+		return calendar.Options{}, err
+	}
+	switch perRowFlag {
+	case 0, 1, 2, 3, 4, 6:
+	default:
+		return calendar.Options{}, fmt.Errorf("--months-per-row must be 1, 2, 3, 4 or 6")
+	}
+
+	highlightWeekdays := make([]int, len(weekdayHighs))
+	for i, w := range weekdayHighs {
+		if highlightWeekdays[i], err = parseWeekday(w); err != nil {
+			return calendar.Options{}, fmt.Errorf("--highlight-day-of-week-in-year: %v", err)
+		}
+	}
+
+	var weekend calendar.Weekend
+	for _, name := range weekendFlag {
+		day, err := calendar.ParseWeekdayName(name)
+		if err != nil {
+			return calendar.Options{}, fmt.Errorf("--weekend: %v", err)
+		}
+		weekend = append(weekend, day)
+	}
 
-	if fullYearFlag {
-		return modeFullYear
+	columns, err := parseColumns(columnsFlag)
+	if err != nil {
+		return calendar.Options{}, fmt.Errorf("--columns: %v", err)
 	}
-	if threeFlag {
-		return modeThreeMonths
+
+	for _, day := range monthDayHigh {
+		if day < 1 || day > 31 {
+			return calendar.Options{}, fmt.Errorf("--highlight-day-of-month: day %d must be between 1 and 31", day)
+		}
+	}
+
+	opts := calendar.Options{
+		Today:              currentDate,
+		NoToday:            noTodayFlag,
+		Highlight:          highlights,
+		HighlightRanges:    rangeFlags,
+		HighlightWeekdays:  highlightWeekdays,
+		HighlightMonthDays: monthDayHigh,
+		NowruzWeek:         nowruzWeek,
+		Colorful:           colorfulFlag,
+		Holidays:           holidaysFlag || len(holidayFiles) > 0,
+		Locale:             locale,
+		HeaderAlign:        headerAlign,
+		DayAlign:           dayAlign,
+		QuarterLabels:      quarterFlag,
+		DayCounts:          dayCounts,
+		AdjacentDays:       adjacentDays,
+		NoLegends:          quietFlag,
+		ReverseWeeks:       reverseWeeks,
+		Columns:            columns,
+		FixedHeight:        fixedHeight,
+		FirstDayHighlight:  firstDayMark,
+		NoPadMonth:         noPadMonth,
+		Weekend:            weekend,
+		ShowGregorian:      showGregYear,
+		Stats:              statsFlag,
+		Width:              widthFlag,
+		MonthsPerRow:       perRowFlag,
 	}
-	if yearFlagSet && !monthFlagSet {
-		return modeFullYear
+	if startDayFlag >= 0 {
+		opts.StartDay = &startDayFlag
+	}
+	return opts, nil
+}
+
+// printNames prints the month or weekday names in the locale, one per line or as
+// a JSON array, for scripts that need the exact strings scal uses
+func printNames(cmd *cobra.Command) error {
+	if listMonths && listWeekdays {
+		return fmt.Errorf("%w: --list-months and --list-weekdays cannot be used together", ErrValidation)
+	}
+	locale, err := parseLocale()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+
+	var names []string
+	if listMonths {
+		for month := minMonth; month <= maxMonth; month++ {
+			names = append(names, locale.MonthName(month))
+		}
+	} else {
+		for weekday := 0; weekday < 7; weekday++ {
+			names = append(names, locale.WeekdayName(weekday))
+		}
+	}
+
+	text := strings.Join(names, "\n") + "\n"
+	if jsonFlag || jsonCompact {
+		data, _ := json.Marshal(names)
+		text = string(data) + "\n"
+	}
+	if _, err := io.WriteString(cmd.OutOrStdout(), text); err != nil {
+		return fmt.Errorf("%w: %w", ErrIO, err)
+	}
+	return nil
+}
+
+// printFirstWeekday prints the weekday the month starts on, as a sentence in the
+// locale's names or, with --json, as an object with transliterated names
+func printFirstWeekday(cmd *cobra.Command) error {
+	locale, err := parseLocale()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+	weekday := calendar.GetDayOfWeek(yearFlag, monthFlag, 1)
+
+	text := fmt.Sprintf("%s %d starts on %s\n", locale.MonthName(monthFlag), yearFlag, locale.FullWeekdayName(weekday))
+	if jsonFlag || jsonCompact {
+		data, _ := json.Marshal(struct {
+			Year         int    `json:"year"`
+			Month        int    `json:"month"`
+			Name         string `json:"name"`
+			Weekday      string `json:"weekday"`
+			WeekdayIndex int    `json:"weekday_index"`
+		}{yearFlag, monthFlag, calendar.LocaleTransliterated.MonthName(monthFlag), calendar.LocaleTransliterated.FullWeekdayName(weekday), weekday})
+		text = string(data) + "\n"
+	}
+	if _, err := io.WriteString(cmd.OutOrStdout(), text); err != nil {
+		return fmt.Errorf("%w: %w", ErrIO, err)
+	}
+	return nil
+}
+
+// monthOffsets returns the first and last month shown by the multi-month modes
+// that are placed relative to the month, as offsets from it
+func monthOffsets(mode displayMode) (first, last int) {
+	switch mode {
+	case modeThreeMonths:
+		return -1, 1
+	case modeAhead:
+		return 0, aheadFlag - 1
+	case modeSpan:
+		return spanFirst, spanLast
+	default:
+		return 0, 0
+	}
+}
+
+// parseSpan parses a --span value written as FIRST..LAST, e.g. -2..+2
+func parseSpan(s string) (int, int, error) {
+	before, after, found := strings.Cut(s, "..")
+	first, err := strconv.Atoi(strings.TrimSpace(before))
+	if !found || err != nil {
+		return 0, 0, fmt.Errorf("invalid --span %q, expected FIRST..LAST such as -2..+2", s)
+	}
+	last, err := strconv.Atoi(strings.TrimSpace(after))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid --span %q, expected FIRST..LAST such as -2..+2", s)
+	}
+
+	switch {
+	case last < first:
+		return 0, 0, fmt.Errorf("invalid --span %q, LAST is before FIRST", s)
+	case last-first+1 > maxSpanMonths:
+		return 0, 0, fmt.Errorf("--span %q covers %d months, at most %d can be displayed", s, last-first+1, maxSpanMonths)
+	}
+	return first, last, nil
+}
+
+// parseColumns parses the --columns weekdays, each a name or a FIRST..LAST range
+// of names, into their columns. No weekdays give nil, which shows them all.
+func parseColumns(values []string) ([]int, error) {
+	var columns []int
+	for _, value := range values {
+		first, last, isRange := strings.Cut(value, "..")
+		from, err := calendar.ParseWeekdayName(first)
+		if err != nil {
+			return nil, err
+		}
+		to := from
+		if isRange {
+			if to, err = calendar.ParseWeekdayName(last); err != nil {
+				return nil, err
+			}
+			if to < from {
+				return nil, fmt.Errorf("invalid range %q, %s comes before %s in the week", value, strings.TrimSpace(last), strings.TrimSpace(first))
+			}
+		}
+		for day := from; day <= to; day++ {
+			columns = append(columns, day)
+		}
+	}
+	return columns, nil
+}
+
+// displayedRange returns the dates shown by a display mode. The summary shows no
+// days, so it reports false.
+func displayedRange(mode displayMode) (calendar.DateRange, bool) {
+	firstYear, firstMonth, lastYear, lastMonth := yearFlag, monthFlag, yearFlag, monthFlag
+	switch mode {
+	case modeThreeMonths, modeAhead, modeSpan:
+		first, last := monthOffsets(mode)
+		firstYear, firstMonth = calendar.ShiftMonth(yearFlag, monthFlag, first)
+		lastYear, lastMonth = calendar.ShiftMonth(yearFlag, monthFlag, last)
+	case modeFullYear:
+		firstMonth, lastMonth = minMonth, maxMonth
+	case modeTwoYears:
+		firstMonth, lastYear, lastMonth = minMonth, yearFlag+1, maxMonth
+	case modeSummary:
+		return calendar.DateRange{}, false
+	}
+
+	return calendar.DateRange{
+		Start: calendar.JalaliDate{Year: firstYear, Month: firstMonth, Day: 1},
+		End:   calendar.JalaliDate{Year: lastYear, Month: lastMonth, Day: calendar.GetDaysInMonth(lastYear, lastMonth)},
+	}, true
+}
+
+// warnHiddenHighlights warns about --highlight dates outside the displayed months,
+// which are most likely typos. It is only a warning so scripts keep working.
+func warnHiddenHighlights(cmd *cobra.Command, mode displayMode, dates []calendar.JalaliDate) {
+	shown, ok := displayedRange(mode)
+	if !ok {
+		return
+	}
+	for _, date := range dates {
+		if !shown.Contains(date) {
+			warnf(cmd, "--highlight %s is not in the displayed months", date)
+		}
+	}
+}
+
+// highlightFlags names the flag behind each highlight source in warnings
+var highlightFlags = map[calendar.HighlightSource]string{
+	calendar.HighlightDate:       "--highlight",
+	calendar.HighlightRange:      "--highlight-range",
+	calendar.HighlightMonthDay:   "--highlight-day-of-month",
+	calendar.HighlightWeekday:    "--highlight-day-of-week-in-year",
+	calendar.HighlightNowruzWeek: "--highlight-nowruz-week",
+}
+
+// warnOverlappingHighlights warns about --highlight dates that are given twice or
+// are already highlighted by another flag. The overlap is harmless, as all sources
+// share one style, but usually means a flag does not do what was meant.
+func warnOverlappingHighlights(cmd *cobra.Command, opts calendar.Options) {
+	seen := map[calendar.JalaliDate]bool{}
+	for _, date := range opts.Highlight {
+		if seen[date] {
+			warnf(cmd, "--highlight %s is given more than once", date)
+			continue
+		}
+		seen[date] = true
+
+		var others []string
+		for _, source := range opts.HighlightSources(date) {
+			if source != calendar.HighlightDate {
+				others = append(others, highlightFlags[source])
+			}
+		}
+		if len(others) > 0 {
+			warnf(cmd, "--highlight %s is already highlighted by %s", date, strings.Join(others, ", "))
+		}
+	}
+}
+
+// warnf prints an advisory message to stderr, unless --quiet is set
+func warnf(cmd *cobra.Command, format string, args ...interface{}) {
+	if quietFlag {
+		return
 	}
-	return modeSingleMonth
+	fmt.Fprintf(cmd.ErrOrStderr(), "scal: warning: "+format+"\n", args...)
+}
+
+// parseWeekday accepts a weekday name or a Jalali date and returns its weekday
+func parseWeekday(s string) (int, error) {
+	if date, err := calendar.ParseJalali(s); err == nil {
+		return calendar.GetDayOfWeek(date.Year, date.Month, date.Day), nil
+	}
+	return calendar.ParseWeekdayName(s)
+}
+
+// loadHolidayFiles registers the year specific holidays from each data file
+func loadHolidayFiles(paths []string) error {
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrIO, err)
+		}
+
+		err = calendar.LoadHolidays(f)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("%w: %s: %w", ErrValidation, path, err)
+		}
+	}
+	return nil
+}
+
+// buildTheme returns the theme selected by --invert or --theme-file, with the
+// today style and color flags applied, or nil for the default theme. Hex and
+// 256 colors are brought down to the color depth the terminal announces.
+func buildTheme(cmd *cobra.Command) (*calendar.Theme, error) {
+	theme := calendar.DefaultTheme
+	custom := false
+
+	if invertFlag {
+		if themeFile != "" {
+			return nil, fmt.Errorf("%w: --invert and --theme-file cannot be used together", ErrValidation)
+		}
+		theme, custom = calendar.LightTheme, true
+	}
+	if themeFile != "" {
+		var err error
+		if theme, err = loadThemeFile(themeFile); err != nil {
+			return nil, err
+		}
+		custom = true
+	}
+
+	if cmd.Flags().Changed("today-style") || cmd.Flags().Changed("today-color") {
+		code, err := calendar.TodayCode(todayStyle, todayColor)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrValidation, err)
+		}
+		theme.Today, custom = code, true
+	}
+
+	if !custom {
+		return nil, nil
+	}
+	theme = theme.ForDepth(calendar.DetectColorDepth(os.Getenv("COLORTERM"), os.Getenv("TERM")))
+	return &theme, nil
+}
+
+// loadEventFiles reads the events from each data file
+func loadEventFiles(paths []string) ([]calendar.Event, error) {
+	var events []calendar.Event
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrIO, err)
+		}
+
+		fileEvents, err := calendar.LoadEvents(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", ErrValidation, path, err)
+		}
+		events = append(events, fileEvents...)
+	}
+	return events, nil
+}
+
+// loadThemeFile reads the theme file at path
+func loadThemeFile(path string) (calendar.Theme, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return calendar.Theme{}, fmt.Errorf("%w: %w", ErrIO, err)
+	}
+	defer f.Close()
+
+	theme, err := calendar.LoadTheme(f)
+	if err != nil {
+		return calendar.Theme{}, fmt.Errorf("%w: %s: %w", ErrValidation, path, err)
+	}
+	return theme, nil
 }
 
 type displayMode int
@@ -89,37 +655,252 @@ const (
 	modeSingleMonth displayMode = iota
 	modeThreeMonths
 	modeFullYear
+	modeTwoYears
+	modeSummary
+	modeAhead
+	modeSpan
 )
 
 func runCalendar(cmd *cobra.Command, args []string) error {
 	// Get current Jalali date for defaults and today highlighting
 	currentJalali := getCurrentJalaliDate()
 
-	// Set default values if not provided
-	if yearFlag == 0 {
+	// Plain date for scripts and status bars, bypassing all rendering
+	if isoToday {
+		_, err := fmt.Fprintln(cmd.OutOrStdout(), currentJalali.String())
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrIO, err)
+		}
+		return nil
+	}
+
+	if listMonths || listWeekdays {
+		return printNames(cmd)
+	}
+
+	if cmd.Flags().Changed("month-name") {
+		if cmd.Flags().Changed("month") {
+			return fmt.Errorf("%w: --month and --month-name cannot be used together", ErrValidation)
+		}
+		month, err := calendar.ParseMonthName(monthName)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrValidation, err)
+		}
+		monthFlag = month
+	}
+
+	// Set default values if not provided. An explicit 0 is validated like any other value.
+	if !cmd.Flags().Changed("year") {
 		yearFlag = currentJalali.Year
 	}
-	if monthFlag == 0 {
+	if !cmd.Flags().Changed("month") && !cmd.Flags().Changed("month-name") {
 		monthFlag = currentJalali.Month
 	}
 
 	if err := validateInput(yearFlag, monthFlag); err != nil {
-		return fmt.Errorf("validation error: %w", err)
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+	if firstWeekday {
+		return printFirstWeekday(cmd)
+	}
+	if cmd.Flags().Changed("start-day-override") && (startDayFlag < 0 || startDayFlag >= 7) {
+		return fmt.Errorf("%w: start day override must be between 0 and 6", ErrValidation)
+	}
+
+	if err := loadHolidayFiles(holidayFiles); err != nil {
+		return err
 	}
 
 	// Determine display mode and execute
-	mode := determineDisplayMode(cmd)
+	mode, err := determineDisplayMode(cmd)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+	if selectDay {
+		opts, err := buildOptions(currentJalali)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrValidation, err)
+		}
+		return pickDay(cmd.InOrStdin(), cmd.ErrOrStderr(), cmd.OutOrStdout(), yearFlag, monthFlag, opts)
+	}
+
+	switch mode {
+	case modeAhead:
+		if aheadFlag < 1 || aheadFlag > maxMonth {
+			return fmt.Errorf("%w: --ahead must be between 1 and %d", ErrValidation, maxMonth)
+		}
+	case modeSpan:
+		if spanFirst, spanLast, err = parseSpan(spanFlag); err != nil {
+			return fmt.Errorf("%w: %w", ErrValidation, err)
+		}
+	}
+	if first, last := monthOffsets(mode); first != 0 || last != 0 {
+		if firstYear, _ := calendar.ShiftMonth(yearFlag, monthFlag, first); firstYear < minYear {
+			return fmt.Errorf("%w: the displayed months start before year %d", ErrValidation, minYear)
+		}
+		if lastYear, _ := calendar.ShiftMonth(yearFlag, monthFlag, last); lastYear > maxYear {
+			return fmt.Errorf("%w: the displayed months go past year %d", ErrValidation, maxYear)
+		}
+	}
+	if mode == modeTwoYears && yearFlag+1 > maxYear {
+		return fmt.Errorf("%w: year must be below %d to display two years", ErrValidation, maxYear)
+	}
 
+	if repeatFlag < 1 {
+		return fmt.Errorf("%w: --repeat must be at least 1", ErrValidation)
+	}
+	if (jsonFlag || jsonCompact) && (repeatFlag > 1 || pageBreak) {
+		return fmt.Errorf("%w: --repeat and --page-break cannot be used with JSON output", ErrValidation)
+	}
+
+	// Render into a buffer so the output can be sent through a pager
+	var output bytes.Buffer
+	opts, err := buildOptions(currentJalali)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+	opts.Output = &output
+
+	if opts.Theme, err = buildTheme(cmd); err != nil {
+		return err
+	}
+
+	if opts.Events, err = loadEventFiles(eventFiles); err != nil {
+		return err
+	}
+
+	jsonOutput := jsonFlag || jsonCompact
+	if cmd.Flags().Changed("width") {
+		// An explicit width replaces terminal detection
+		if monthWidth := calendar.MonthWidth(yearFlag, monthFlag, opts); widthFlag < monthWidth {
+			return fmt.Errorf("%w: --width %d is too small, a month needs %d columns", ErrValidation, widthFlag, monthWidth)
+		}
+	} else if mode != modeSummary && !jsonOutput {
+		if err := checkTerminalWidth(calendar.MonthWidth(yearFlag, monthFlag, opts)); err != nil {
+			return err
+		}
+	}
+
+	// Enabled after measuring so only the displayed months are logged
+	if verboseFlag {
+		opts.Logger = log.New(os.Stderr, "scal: ", 0)
+	}
+
+	if !jsonOutput {
+		warnHiddenHighlights(cmd, mode, opts.Highlight)
+		warnOverlappingHighlights(cmd, opts)
+	}
+
+	if jsonOutput {
+		_, err = output.WriteString(renderJSON(mode, opts, jsonCompact))
+	} else {
+		err = display(mode, opts)
+	}
+	if err != nil {
+		return wrapDisplayError(err)
+	}
+	if !jsonOutput {
+		if sections := renderSections(mode, opts); sections != "" {
+			// One blank line between the calendar and the sections, whichever view it is
+			calendarOutput := strings.TrimRight(output.String(), "\n")
+			output.Reset()
+			output.WriteString(calendarOutput + "\n\n" + sections)
+		}
+	}
+
+	ascii, err := useASCIIOutput(encodingFlag)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+	rendered := output.String()
+	if ascii {
+		rendered = calendar.ToASCII(rendered)
+	}
+
+	return wrapDisplayError(writeOutput(cmd.OutOrStdout(), paginate(rendered, repeatFlag, pageBreak), pagerFlag))
+}
+
+// paginate repeats output n times with a form feed between the copies, and after
+// the last one too when pageBreak is set. Each copy ends with a newline before
+// its form feed, so a printer starts the next page on a fresh line.
+func paginate(output string, n int, pageBreak bool) string {
+	if n == 1 && !pageBreak {
+		return output
+	}
+
+	if !strings.HasSuffix(output, "\n") {
+		output += "\n"
+	}
+	pages := strings.Repeat(output+"\f", n-1) + output
+	if pageBreak {
+		pages += "\f"
+	}
+	return pages
+}
+
+// display renders the calendar for a display mode into opts.Output
+func display(mode displayMode, opts calendar.Options) error {
 	switch mode {
+	case modeSummary:
+		return calendar.DisplayYearSummary(yearFlag, opts)
+	case modeTwoYears:
+		return calendar.DisplayTwoYearsTable(yearFlag, opts)
 	case modeFullYear:
-		calendar.DisplayYearTable(yearFlag)
+		return calendar.DisplayYearTable(yearFlag, opts)
 	case modeThreeMonths:
-		calendar.DisplayThreeMonthsTable(yearFlag, monthFlag)
+		return calendar.DisplayThreeMonthsTable(yearFlag, monthFlag, opts)
+	case modeAhead:
+		return calendar.DisplayMonthsAhead(yearFlag, monthFlag, aheadFlag, opts)
+	case modeSpan:
+		return calendar.DisplayMonthSpan(yearFlag, monthFlag, spanFirst, spanLast, opts)
 	case modeSingleMonth:
-		calendar.DisplayMonthTable(yearFlag, monthFlag, currentJalali)
+		return calendar.DisplayMonthTable(yearFlag, monthFlag, opts)
 	default:
 		return fmt.Errorf("unknown display mode")
 	}
+}
 
-	return nil
+// useASCIIOutput reports whether output must be transliterated to ASCII for the
+// requested encoding. In auto mode the console is switched to UTF-8 where
+// needed, falling back to ASCII when that is not possible.
+func useASCIIOutput(encoding string) (bool, error) {
+	switch encoding {
+	case "auto":
+		return !enableUTF8Console(), nil
+	case "utf8":
+		return false, nil
+	case "ascii":
+		return true, nil
+	default:
+		return false, fmt.Errorf("unknown output encoding %q (valid: auto, utf8, ascii)", encoding)
+	}
+}
+
+// exactArgs is cobra.ExactArgs reporting a wrong argument count as a validation error
+func exactArgs(n int) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if err := cobra.ExactArgs(n)(cmd, args); err != nil {
+			return fmt.Errorf("%w: %w", ErrValidation, err)
+		}
+		return nil
+	}
+}
+
+// rangeArgs is cobra.RangeArgs reporting a wrong argument count as a validation error
+func rangeArgs(minArgs, maxArgs int) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if err := cobra.RangeArgs(minArgs, maxArgs)(cmd, args); err != nil {
+			return fmt.Errorf("%w: %w", ErrValidation, err)
+		}
+		return nil
+	}
+}
+
+// wrapDisplayError classifies an error returned by the display functions.
+// Range errors are passed through; anything else is a failure writing output.
+func wrapDisplayError(err error) error {
+	if err == nil || errors.Is(err, calendar.ErrOutOfRange) {
+		return err
+	}
+	return fmt.Errorf("%w: %w", ErrIO, err)
 }
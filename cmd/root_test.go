@@ -0,0 +1,176 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/alizmhdi/shamsi-calendar/calendar"
+)
+
+// pinnedNow is 12 Mordad 1403, the date the golden files are rendered on
+var pinnedNow = time.Date(2024, time.August, 2, 12, 0, 0, 0, time.UTC)
+
+func TestCalendarViews(t *testing.T) {
+	tests := []struct {
+		golden string
+		args   []string
+	}{
+		{"month", []string{"--utc"}},
+		{"three_months", []string{"--utc", "-3"}},
+		{"year", []string{"--utc", "-Y"}},
+		{"year_persian", []string{"--utc", "-Y", "--locale", "fa"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.golden, func(t *testing.T) {
+			setClock(t, pinnedNow)
+			out, err := execute(t, tt.args...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertGolden(t, tt.golden, out)
+		})
+	}
+}
+
+func TestMonthSpan(t *testing.T) {
+	tests := []struct {
+		name  string
+		args  []string
+		names []string
+		want  []string
+	}{
+		{"forward across Nowruz", []string{"-y", "1403", "-m", "12", "--span", "0..+2"},
+			[]string{"Esfand 1403", "Farvardin 1404", "Ordibehesht 1404"}, []string{"1403-12", "1404-01", "1404-02"}},
+		{"backward across Nowruz", []string{"-y", "1404", "-m", "1", "--span", "-2..0"},
+			[]string{"Bahman 1403", "Esfand 1403", "Farvardin 1404"}, []string{"1403-11", "1403-12", "1404-01"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := execute(t, tt.args...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			at := -1
+			for _, name := range tt.names {
+				next := strings.Index(out, name)
+				if next <= at {
+					t.Fatalf("output does not show %s in order:\n%s", strings.Join(tt.names, ", "), out)
+				}
+				at = next
+			}
+		})
+		t.Run(tt.name+" json", func(t *testing.T) {
+			out, err := execute(t, append(tt.args, "--json")...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := jsonMonths(t, out); strings.Join(got, " ") != strings.Join(tt.want, " ") {
+				t.Errorf("JSON holds months %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("start after end", func(t *testing.T) {
+		_, err := execute(t, "-y", "1403", "-m", "5", "--span", "+1..-1")
+		if !errors.Is(err, ErrValidation) || ExitCode(err) != ExitValidation {
+			t.Errorf("error %v (exit code %d), want %v with exit code %d", err, ExitCode(err), ErrValidation, ExitValidation)
+		}
+	})
+}
+
+func TestMonthsAhead(t *testing.T) {
+	args := []string{"-y", "1403", "-m", "10", "--ahead", "6"}
+	names := []string{"Dey 1403", "Bahman 1403", "Esfand 1403", "Farvardin 1404", "Ordibehesht 1404", "Khordad 1404"}
+	want := []string{"1403-10", "1403-11", "1403-12", "1404-01", "1404-02", "1404-03"}
+
+	t.Run("text", func(t *testing.T) {
+		out, err := execute(t, args...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		at := -1
+		for _, name := range names {
+			next := strings.Index(out, name)
+			if next <= at {
+				t.Fatalf("%s is missing or out of order:\n%s", name, out)
+			}
+			at = next
+		}
+	})
+	t.Run("json", func(t *testing.T) {
+		out, err := execute(t, append(args, "--json")...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := jsonMonths(t, out); strings.Join(got, " ") != strings.Join(want, " ") {
+			t.Errorf("JSON holds months %v, want %v", got, want)
+		}
+	})
+}
+
+func TestCurrentDateAtTehranMidnight(t *testing.T) {
+	// 20:45 UTC on 19 March 2024 is a quarter past midnight of Nowruz 1403 in Tehran
+	setClock(t, time.Date(2024, time.March, 19, 20, 45, 0, 0, time.UTC))
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"--tz", "Asia/Tehran", "--iso-today"}, "1403-01-01\n"},
+		{[]string{"--utc", "--iso-today"}, "1402-12-29\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args[0], func(t *testing.T) {
+			out, err := execute(t, tt.args...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out != tt.want {
+				t.Errorf("printed %q, want %q", out, tt.want)
+			}
+		})
+	}
+
+	tehran, err := time.LoadLocation("Asia/Tehran")
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved := location
+	location = tehran
+	t.Cleanup(func() { location = saved })
+
+	today := getCurrentJalaliDate()
+	if want := (calendar.JalaliDate{Year: 1403, Month: 1, Day: 1}); today != want {
+		t.Fatalf("getCurrentJalaliDate() = %v, want %v", today, want)
+	}
+	// Saturday is Shanbe, the first column
+	if got, want := calendar.GetDayOfWeek(today.Year, today.Month, today.Day), (int(currentTime().Weekday())+1)%7; got != want {
+		t.Errorf("weekday column %d, want %d for a %v", got, want, currentTime().Weekday())
+	}
+}
+
+func TestWeekendStats(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{nil, "Weekend days: 4  Holidays: 0  Working days: 27"},
+		{[]string{"--weekend", "Shanbe,Yekshanbe"}, "Weekend days: 8  Holidays: 0  Working days: 23"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.args), func(t *testing.T) {
+			setClock(t, pinnedNow)
+			out, err := execute(t, append([]string{"--utc", "--stats", "-y", "1403", "-m", "5"}, tt.args...)...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(out, tt.want) {
+				t.Errorf("output lacks %q:\n%s", tt.want, out)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/alizmhdi/shamsi-calendar/calendar"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	searchHoliday      string
+	searchEvent        string
+	searchYear         int
+	searchHolidayFiles []string
+	searchEventFiles   []string
+)
+
+var searchCmd = &cobra.Command{
+	Use:   "search",
+	Short: "Find the dates of holidays or events by name",
+	Long: `Find the dates of holidays or events by name in a year.
+
+The name matches when it contains the search text, ignoring case and spaces,
+in English or in Persian. Each match is printed with its Jalali and Gregorian dates.`,
+	Example: "  scal search --holiday nowruz\n  scal search --event meeting --events-file events.json -y 1403",
+	Args:    exactArgs(0),
+	RunE:    runSearch,
+}
+
+func init() {
+	searchCmd.Flags().StringVar(&searchHoliday, "holiday", "", "search the holidays for this name")
+	searchCmd.Flags().StringVar(&searchEvent, "event", "", "search the events of --events-file for this name")
+	searchCmd.Flags().IntVarP(&searchYear, "year", "y", 0, "year to search (default: current year)")
+	searchCmd.Flags().StringArrayVar(&searchHolidayFiles, "holidays-file", nil, "also search the year specific holidays of a JSON file")
+	searchCmd.Flags().StringArrayVar(&searchEventFiles, "events-file", nil, "JSON file with the events to search")
+	rootCmd.AddCommand(searchCmd)
+}
+
+func runSearch(cmd *cobra.Command, args []string) error {
+	if searchHoliday == "" && searchEvent == "" {
+		return fmt.Errorf("%w: give --holiday or --event to search for", ErrValidation)
+	}
+	if !cmd.Flags().Changed("year") {
+		searchYear = getCurrentJalaliDate().Year
+	}
+	if err := validateInput(searchYear, minMonth); err != nil {
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+	locale, err := parseLocale()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+
+	out := cmd.OutOrStdout()
+	found := false
+	if searchHoliday != "" {
+		if err := loadHolidayFiles(searchHolidayFiles); err != nil {
+			return err
+		}
+		for _, h := range calendar.SearchHolidays(searchYear, searchHoliday) {
+			found = true
+			date := calendar.JalaliDate{Year: searchYear, Month: h.Month, Day: h.Day}
+			if err := printMatch(out, date, h.LocalName(locale)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if searchEvent != "" {
+		events, err := loadEventFiles(searchEventFiles)
+		if err != nil {
+			return err
+		}
+		year := calendar.DateRange{Start: calendar.FirstDayOfYear(searchYear), End: calendar.LastDayOfYear(searchYear)}
+		for _, o := range calendar.ExpandEvents(events, year) {
+			if !calendar.MatchName(o.Name, searchEvent) {
+				continue
+			}
+			found = true
+			if err := printMatch(out, o.Date, o.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !found {
+		// Exits with status 1, like grep when nothing matches
+		return fmt.Errorf("nothing found in %d", searchYear)
+	}
+	return nil
+}
+
+// printMatch writes a search result with its Jalali and Gregorian dates
+func printMatch(out io.Writer, date calendar.JalaliDate, name string) error {
+	gy, gm, gd := calendar.JalaliToGregorian(date.Year, date.Month, date.Day)
+	if _, err := fmt.Fprintf(out, "%s  %04d-%02d-%02d  %s\n", date, gy, gm, gd, name); err != nil {
+		return fmt.Errorf("%w: %w", ErrIO, err)
+	}
+	return nil
+}
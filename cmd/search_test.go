@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSearch(t *testing.T) {
+	events := filepath.Join(t.TempDir(), "events.json")
+	err := os.WriteFile(events, []byte(`{"events": [
+		{"name": "Dentist", "date": "1403-02-10"},
+		{"name": "Rent", "date": "1403-01-31", "repeat": "monthly"}
+	]}`), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		args  []string
+		want  []string
+		lines int
+	}{
+		{
+			name:  "holiday",
+			args:  []string{"--holiday", "day", "-y", "1403"},
+			want:  []string{"1403-01-12  2024-03-31  Islamic Republic Day", "1403-12-29  2025-03-19  Oil Nationalization Day"},
+			lines: 3,
+		},
+		{
+			name:  "persian",
+			args:  []string{"--holiday", "نوروز", "-y", "1404", "--locale", "fa"},
+			want:  []string{"1404-01-01  2025-03-21  نوروز"},
+			lines: 4,
+		},
+		{
+			name:  "event",
+			args:  []string{"--event", "dentist", "--events-file", events, "-y", "1403"},
+			want:  []string{"1403-02-10  2024-04-29  Dentist"},
+			lines: 1,
+		},
+		{
+			// a monthly event on the 31st falls on the last day of shorter months
+			name:  "monthly event",
+			args:  []string{"--event", "rent", "--events-file", events, "-y", "1403"},
+			want:  []string{"1403-07-30  2024-10-21  Rent", "1403-12-30  2025-03-20  Rent"},
+			lines: 12,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := execute(t, append([]string{"search"}, tt.args...)...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, line := range tt.want {
+				if !strings.Contains(out, line+"\n") {
+					t.Errorf("output lacks %q:\n%s", line, out)
+				}
+			}
+			if n := strings.Count(out, "\n"); n != tt.lines {
+				t.Errorf("%d matches, want %d:\n%s", n, tt.lines, out)
+			}
+		})
+	}
+}
+
+func TestSearchFailures(t *testing.T) {
+	t.Run("nothing found", func(t *testing.T) {
+		_, err := execute(t, "search", "--holiday", "christmas", "-y", "1403")
+		if err == nil || ExitCode(err) != ExitFailure {
+			t.Errorf("error %v, want one exiting with %d", err, ExitFailure)
+		}
+	})
+
+	t.Run("no query", func(t *testing.T) {
+		_, err := execute(t, "search")
+		if code := ExitCode(err); err == nil || code != ExitValidation {
+			t.Errorf("error %v, exit code %d, want %d", err, code, ExitValidation)
+		}
+	})
+}
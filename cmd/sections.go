@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/alizmhdi/shamsi-calendar/calendar"
+)
+
+// A single month prints its holiday and event legends and its stats below the
+// table. Views of several months list the same sections after all the months,
+// in the same order: holidays, events, then stats. Each section starts with a
+// title line and is separated from the one before by a blank line.
+
+// eventJSON describes an event occurrence in the JSON output
+type eventJSON struct {
+	Date calendar.JalaliDate `json:"date"`
+	Name string              `json:"name"`
+}
+
+// renderSections returns the sections listed below a multi-month view, or ""
+// when none was asked for. --quiet leaves out the holiday and event lists, as it
+// does the legends of a single month.
+func renderSections(mode displayMode, opts calendar.Options) string {
+	shown, ok := displayedRange(mode)
+	if !ok || mode == modeSingleMonth {
+		return ""
+	}
+
+	var sections []string
+	if opts.Holidays && !opts.NoLegends {
+		sections = appendSection(sections, "Holidays", holidayLines(shown, opts.Locale))
+	}
+	if len(opts.Events) > 0 && !opts.NoLegends {
+		sections = appendSection(sections, "Events", eventLines(shown, opts.Events))
+	}
+	if opts.Stats {
+		sections = appendSection(sections, "Stats", statsLines(shown, opts))
+	}
+	return strings.Join(sections, "\n")
+}
+
+// appendSection adds a titled section with tab separated, aligned lines; sections
+// without lines are left out
+func appendSection(sections []string, title string, lines []string) []string {
+	if len(lines) == 0 {
+		return sections
+	}
+
+	out := &strings.Builder{}
+	out.WriteString(title + "\n")
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	for _, line := range lines {
+		fmt.Fprintf(w, "  %s\n", line)
+	}
+	w.Flush()
+	return append(sections, out.String())
+}
+
+// holidayLines lists the holidays within r with their Gregorian dates
+func holidayLines(r calendar.DateRange, locale calendar.Locale) []string {
+	var lines []string
+	for d := r.Start; r.Contains(d); d = d.AddDays(1) {
+		holidays := calendar.HolidaysOn(d)
+		if len(holidays) == 0 {
+			continue
+		}
+
+		names := make([]string, len(holidays))
+		for i, h := range holidays {
+			names[i] = h.LocalName(locale)
+		}
+		gy, gm, gd := calendar.JalaliToGregorian(d.Year, d.Month, d.Day)
+		lines = append(lines, fmt.Sprintf("%s\t%04d-%02d-%02d\t%s", d, gy, gm, gd, strings.Join(names, ", ")))
+	}
+	return lines
+}
+
+// eventLines lists the event occurrences within r
+func eventLines(r calendar.DateRange, events []calendar.Event) []string {
+	var lines []string
+	for _, o := range calendar.ExpandEvents(events, r) {
+		lines = append(lines, fmt.Sprintf("%s\t%s", o.Date, o.Name))
+	}
+	return lines
+}
+
+// statsLines gives the stats of each month within r
+func statsLines(r calendar.DateRange, opts calendar.Options) []string {
+	var lines []string
+	for year, month := r.Start.Year, r.Start.Month; year < r.End.Year || year == r.End.Year && month <= r.End.Month; year, month = calendar.ShiftMonth(year, month, 1) {
+		stats := calendar.GetMonthStatsWithWeekend(year, month, opts.Weekend)
+		lines = append(lines, fmt.Sprintf("%s %d\t%s", opts.Locale.MonthName(month), year, stats))
+	}
+	return lines
+}
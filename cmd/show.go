@@ -0,0 +1,22 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var showCmd = &cobra.Command{
+	Use:     "show",
+	Aliases: []string{"calendar"},
+	Short:   "Display the calendar, like running scal without a command",
+	Long: `Display the calendar. This is what scal does without a command; show names
+it explicitly and accepts exactly the same flags.`,
+	Example: "  scal show\n  scal show -Y -y 1404\n  scal calendar -3 --holidays",
+	Args:    exactArgs(0),
+	RunE:    runCalendar,
+}
+
+func init() {
+	// Share the root flags themselves, so both commands parse and document them identically
+	showCmd.Flags().AddFlagSet(rootCmd.Flags())
+	rootCmd.AddCommand(showCmd)
+}
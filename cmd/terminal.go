@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+)
+
+// isTerminal reports whether f is attached to a terminal
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// checkTerminalWidth returns an error when stdout is a terminal narrower than
+// monthWidth, the width of a single rendered month, since the calendar would
+// wrap into an unreadable layout
+func checkTerminalWidth(monthWidth int) error {
+	if !isTerminal(os.Stdout) {
+		return nil
+	}
+
+	width, _, ok := terminalSize()
+	if !ok || width >= monthWidth {
+		return nil
+	}
+	return fmt.Errorf("terminal is %d columns wide but a month needs %d; widen the terminal, pipe the output, or use --summary", width, monthWidth)
+}
@@ -0,0 +1,19 @@
+//go:build !(linux || darwin || freebsd || netbsd || openbsd || dragonfly)
+
+package cmd
+
+import (
+	"os"
+	"strconv"
+)
+
+// terminalSize returns the terminal size advertised through $COLUMNS and $LINES.
+// The height is zero when $LINES is not set.
+func terminalSize() (width, height int, ok bool) {
+	width, err := strconv.Atoi(os.Getenv("COLUMNS"))
+	if err != nil || width <= 0 {
+		return 0, 0, false
+	}
+	height, _ = strconv.Atoi(os.Getenv("LINES"))
+	return width, height, true
+}
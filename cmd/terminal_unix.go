@@ -0,0 +1,28 @@
+//go:build linux || darwin || freebsd || netbsd || openbsd || dragonfly
+
+package cmd
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// winsize mirrors the kernel structure filled in by TIOCGWINSZ
+type winsize struct {
+	rows    uint16
+	cols    uint16
+	xPixels uint16
+	yPixels uint16
+}
+
+// terminalSize returns the width and height of the terminal attached to stdout.
+// The height is zero when the terminal does not report it.
+func terminalSize() (width, height int, ok bool) {
+	var ws winsize
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, os.Stdout.Fd(), uintptr(syscall.TIOCGWINSZ), uintptr(unsafe.Pointer(&ws)))
+	if errno != 0 || ws.cols == 0 {
+		return 0, 0, false
+	}
+	return int(ws.cols), int(ws.rows), true
+}
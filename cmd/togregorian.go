@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/alizmhdi/shamsi-calendar/calendar"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	toGregorianFormat   string
+	fromGregorianFormat string
+	precisionFlag       string
+)
+
+var toGregorianCmd = &cobra.Command{
+	Use:   "to-gregorian DATE",
+	Short: "Convert a Jalali date to the Gregorian calendar",
+	Long: `Convert a Jalali date written as YYYY-MM-DD (or YYYY/MM/DD) to the Gregorian calendar.
+
+The date may be followed by a time of day, as in "1403-05-12 14:30" or
+1403-05-12T14:30:05, which is carried through to the Gregorian date and time.
+--precision chooses how much of the time is printed; by default as much as given.
+
+--format accepts the tokens YYYY, YY, MMMM (month name), MM, M, DD, D and dddd
+(weekday name), and HH, mm, ss and zz for the time of day.`,
+	Example: "  scal to-gregorian 1403-05-12\n  scal to-gregorian 1403-05-12 --format \"dddd, D MMMM YYYY\"\n  scal to-gregorian \"1403-05-12 14:30\" --tz Asia/Tehran",
+	Args:    exactArgs(1),
+	RunE:    runToGregorian,
+}
+
+var fromGregorianCmd = &cobra.Command{
+	Use:   "from-gregorian DATE",
+	Short: "Convert a Gregorian date to the Jalali calendar",
+	Long: `Convert a Gregorian date written as YYYY-MM-DD (or YYYY/MM/DD) to the Jalali calendar.
+
+The date may be followed by a time of day, as in "2024-08-02 14:30" or
+2024-08-02T14:30:05, which is carried through to the Jalali date and time.
+--precision chooses how much of the time is printed; by default as much as given.
+
+--format accepts the tokens YYYY, YY, MMMM (month name), MM, M, DD, D and dddd
+(weekday name), and HH, mm, ss and zz for the time of day. Names follow --locale.`,
+	Example: "  scal from-gregorian 2024-07-22\n  scal from-gregorian 2024-07-22 --format \"dddd D MMMM YYYY\"\n  scal from-gregorian 2024-08-02T14:30",
+	Args:    exactArgs(1),
+	RunE:    runFromGregorian,
+}
+
+func init() {
+	toGregorianCmd.Flags().StringVar(&toGregorianFormat, "format", "YYYY-MM-DD", "layout of the printed date")
+	fromGregorianCmd.Flags().StringVar(&fromGregorianFormat, "format", "YYYY-MM-DD", "layout of the printed date")
+	for _, c := range []*cobra.Command{toGregorianCmd, fromGregorianCmd, convertCmd} {
+		c.Flags().StringVar(&precisionFlag, "precision", "", "time of day to print: day, minute or second (default: as given)")
+	}
+	rootCmd.AddCommand(toGregorianCmd, fromGregorianCmd)
+}
+
+func runToGregorian(cmd *cobra.Command, args []string) error {
+	t, precision, err := calendar.ParseJalaliTime(args[0], location)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+	layout, err := outputLayout(cmd, toGregorianFormat, precision)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintln(cmd.OutOrStdout(), calendar.FormatGregorian(t, layout)); err != nil {
+		return fmt.Errorf("%w: %w", ErrIO, err)
+	}
+	return nil
+}
+
+func runFromGregorian(cmd *cobra.Command, args []string) error {
+	t, precision, err := calendar.ParseGregorianTime(args[0], location)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+	locale, err := parseLocale()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+	layout, err := outputLayout(cmd, fromGregorianFormat, precision)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintln(cmd.OutOrStdout(), calendar.FormatTime(t, layout, locale)); err != nil {
+		return fmt.Errorf("%w: %w", ErrIO, err)
+	}
+	return nil
+}
+
+// outputLayout returns the layout a converted date is printed with: --format when
+// given, otherwise the date with as much of the time of day as --precision asks
+// for or, without it, as the input gave
+func outputLayout(cmd *cobra.Command, format string, given calendar.Precision) (string, error) {
+	if cmd.Flags().Changed("format") {
+		return format, nil
+	}
+	if precisionFlag == "" {
+		return given.Layout(), nil
+	}
+
+	precision, err := calendar.ParsePrecision(precisionFlag)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+	return precision.Layout(), nil
+}
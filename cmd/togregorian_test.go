@@ -0,0 +1,35 @@
+package cmd
+
+import "testing"
+
+func TestConvertWithTime(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"to-gregorian", "1403-05-12"}, "2024-08-02\n"},
+		{[]string{"to-gregorian", "1403-05-12 14:30"}, "2024-08-02 14:30\n"},
+		{[]string{"to-gregorian", "1403-05-12T14:30:05", "--precision", "minute"}, "2024-08-02 14:30\n"},
+		{[]string{"from-gregorian", "2024-08-02 14:30:05"}, "1403-05-12 14:30:05\n"},
+		{[]string{"from-gregorian", "2024-08-02", "--precision", "second"}, "1403-05-12 00:00:00\n"},
+		{[]string{"from-gregorian", "2024-08-02 14:30", "--precision", "day"}, "1403-05-12\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args[1], func(t *testing.T) {
+			out, err := execute(t, append(tt.args, "--utc")...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out != tt.want {
+				t.Errorf("%v printed %q, want %q", tt.args, out, tt.want)
+			}
+		})
+	}
+
+	t.Run("unknown precision", func(t *testing.T) {
+		_, err := execute(t, "to-gregorian", "1403-05-12", "--precision", "hour")
+		if code := ExitCode(err); code != ExitValidation {
+			t.Errorf("error %v, exit code %d, want %d", err, code, ExitValidation)
+		}
+	})
+}